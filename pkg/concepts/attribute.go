@@ -0,0 +1,187 @@
+/*
+Copyright (c) 2019 Red Hat, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+  http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package concepts
+
+// Attribute represents one of the attributes of a struct or class type.
+type Attribute struct {
+	owner     *Type
+	name      *Name
+	doc       string
+	typ       *Type
+	nullable  bool
+	required  bool
+	format    string
+	min       *float64
+	max       *float64
+	pattern   string
+	minLength *int
+	maxLength *int
+	enum      []string
+	examples  []string
+}
+
+// NewAttribute creates a new attribute with the given name and type.
+func NewAttribute(name *Name, typ *Type) *Attribute {
+	return &Attribute{
+		name: name,
+		typ:  typ,
+	}
+}
+
+// Owner returns the type that this attribute belongs to.
+func (a *Attribute) Owner() *Type {
+	return a.owner
+}
+
+// Name returns the name of the attribute.
+func (a *Attribute) Name() *Name {
+	return a.name
+}
+
+// Doc returns the documentation of the attribute.
+func (a *Attribute) Doc() string {
+	return a.doc
+}
+
+// SetDoc sets the documentation of the attribute.
+func (a *Attribute) SetDoc(value string) {
+	a.doc = value
+}
+
+// Type returns the type of the attribute.
+func (a *Attribute) Type() *Type {
+	return a.typ
+}
+
+// Nullable returns true if the attribute has been marked with the `@Nullable` annotation.
+func (a *Attribute) Nullable() bool {
+	return a.nullable
+}
+
+// SetNullable sets whether the attribute is nullable.
+func (a *Attribute) SetNullable(value bool) {
+	a.nullable = value
+}
+
+// Required returns true if the attribute has been marked with the `@Required` annotation.
+func (a *Attribute) Required() bool {
+	return a.required
+}
+
+// SetRequired sets whether the attribute is required.
+func (a *Attribute) SetRequired(value bool) {
+	a.required = value
+}
+
+// Format returns the value of the `@Format` annotation, or the empty string if the attribute
+// doesn't have one.
+func (a *Attribute) Format() string {
+	return a.format
+}
+
+// SetFormat sets the value of the `@Format` annotation.
+func (a *Attribute) SetFormat(value string) {
+	a.format = value
+}
+
+// Min returns the value of the `@Min` annotation and true if the attribute has one, or zero and
+// false otherwise.
+func (a *Attribute) Min() (value float64, ok bool) {
+	if a.min == nil {
+		return 0, false
+	}
+	return *a.min, true
+}
+
+// SetMin sets the value of the `@Min` annotation.
+func (a *Attribute) SetMin(value float64) {
+	a.min = &value
+}
+
+// Max returns the value of the `@Max` annotation and true if the attribute has one, or zero and
+// false otherwise.
+func (a *Attribute) Max() (value float64, ok bool) {
+	if a.max == nil {
+		return 0, false
+	}
+	return *a.max, true
+}
+
+// SetMax sets the value of the `@Max` annotation.
+func (a *Attribute) SetMax(value float64) {
+	a.max = &value
+}
+
+// Pattern returns the value of the `@Pattern` annotation, or the empty string if the attribute
+// doesn't have one.
+func (a *Attribute) Pattern() string {
+	return a.pattern
+}
+
+// SetPattern sets the value of the `@Pattern` annotation.
+func (a *Attribute) SetPattern(value string) {
+	a.pattern = value
+}
+
+// MinLength returns the value of the `@MinLength` annotation and true if the attribute has one, or
+// zero and false otherwise.
+func (a *Attribute) MinLength() (value int, ok bool) {
+	if a.minLength == nil {
+		return 0, false
+	}
+	return *a.minLength, true
+}
+
+// SetMinLength sets the value of the `@MinLength` annotation.
+func (a *Attribute) SetMinLength(value int) {
+	a.minLength = &value
+}
+
+// MaxLength returns the value of the `@MaxLength` annotation and true if the attribute has one, or
+// zero and false otherwise.
+func (a *Attribute) MaxLength() (value int, ok bool) {
+	if a.maxLength == nil {
+		return 0, false
+	}
+	return *a.maxLength, true
+}
+
+// SetMaxLength sets the value of the `@MaxLength` annotation.
+func (a *Attribute) SetMaxLength(value int) {
+	a.maxLength = &value
+}
+
+// Enum returns the values of the `@Enum` annotation, or nil if the attribute doesn't have one.
+func (a *Attribute) Enum() []string {
+	return a.enum
+}
+
+// SetEnum sets the values of the `@Enum` annotation.
+func (a *Attribute) SetEnum(values []string) {
+	a.enum = values
+}
+
+// Examples returns the values of the `@Example` annotation, or nil if the attribute doesn't have
+// one.
+func (a *Attribute) Examples() []string {
+	return a.examples
+}
+
+// SetExamples sets the values of the `@Example` annotation.
+func (a *Attribute) SetExamples(values []string) {
+	a.examples = values
+}
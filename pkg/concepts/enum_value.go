@@ -0,0 +1,40 @@
+/*
+Copyright (c) 2019 Red Hat, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+  http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package concepts
+
+// EnumValue represents one of the values of an enum type.
+type EnumValue struct {
+	owner *Type
+	name  *Name
+}
+
+// NewEnumValue creates a new enum value with the given name.
+func NewEnumValue(name *Name) *EnumValue {
+	return &EnumValue{
+		name: name,
+	}
+}
+
+// Owner returns the enum type that this value belongs to.
+func (v *EnumValue) Owner() *Type {
+	return v.owner
+}
+
+// Name returns the name of the value.
+func (v *EnumValue) Name() *Name {
+	return v.name
+}
@@ -0,0 +1,63 @@
+/*
+Copyright (c) 2019 Red Hat, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+  http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package concepts
+
+// Error represents one of the errors that a service can return, identified by a numeric code that
+// is unique within the service.
+type Error struct {
+	service *Service
+	code    int
+	status  int
+	doc     string
+}
+
+// NewError creates a new error with the given numeric code.
+func NewError(code int) *Error {
+	return &Error{
+		code: code,
+	}
+}
+
+// Service returns the service that this error belongs to.
+func (e *Error) Service() *Service {
+	return e.service
+}
+
+// Code returns the numeric code of the error, unique within its service.
+func (e *Error) Code() int {
+	return e.code
+}
+
+// Status returns the HTTP status code that responses carrying this error use.
+func (e *Error) Status() int {
+	return e.status
+}
+
+// SetStatus sets the HTTP status code of the error.
+func (e *Error) SetStatus(value int) {
+	e.status = value
+}
+
+// Doc returns the documentation of the error.
+func (e *Error) Doc() string {
+	return e.doc
+}
+
+// SetDoc sets the documentation of the error.
+func (e *Error) SetDoc(value string) {
+	e.doc = value
+}
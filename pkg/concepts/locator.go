@@ -0,0 +1,71 @@
+/*
+Copyright (c) 2019 Red Hat, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+  http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package concepts
+
+// Locator represents one of the segments of a path, linking a resource to one of the sub-resources
+// reachable from it.
+type Locator struct {
+	owner    *Resource
+	name     *Name
+	variable bool
+	target   *Resource
+}
+
+// NewLocator creates a new locator with the given name.
+func NewLocator(name *Name) *Locator {
+	return &Locator{
+		name: name,
+	}
+}
+
+// Owner returns the resource that this locator hangs off, i.e. the resource that the path
+// containing this locator was at before following it.
+func (l *Locator) Owner() *Resource {
+	return l.owner
+}
+
+// SetOwner sets the resource that this locator hangs off.
+func (l *Locator) SetOwner(value *Resource) {
+	l.owner = value
+}
+
+// Name returns the name of the locator.
+func (l *Locator) Name() *Name {
+	return l.name
+}
+
+// Variable returns true if this locator corresponds to a path variable, for example the
+// `{cluster_id}` segment of `/api/clusters_mgmt/v1/clusters/{cluster_id}`, as opposed to a fixed
+// segment like `clusters`.
+func (l *Locator) Variable() bool {
+	return l.variable
+}
+
+// SetVariable sets whether the locator is a path variable.
+func (l *Locator) SetVariable(value bool) {
+	l.variable = value
+}
+
+// Target returns the resource that this locator points to.
+func (l *Locator) Target() *Resource {
+	return l.target
+}
+
+// SetTarget sets the resource that this locator points to.
+func (l *Locator) SetTarget(value *Resource) {
+	l.target = value
+}
@@ -0,0 +1,101 @@
+/*
+Copyright (c) 2019 Red Hat, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+  http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package concepts
+
+// Method represents one of the methods of a resource, for example `Add` or `Get`.
+type Method struct {
+	owner     *Resource
+	name      *Name
+	doc       string
+	request   []*Parameter
+	response  []*Parameter
+	errors    []*Error
+	multipart bool
+}
+
+// NewMethod creates a new method with the given name.
+func NewMethod(name *Name) *Method {
+	return &Method{
+		name: name,
+	}
+}
+
+// Owner returns the resource that this method belongs to.
+func (m *Method) Owner() *Resource {
+	return m.owner
+}
+
+// Name returns the name of the method.
+func (m *Method) Name() *Name {
+	return m.name
+}
+
+// Doc returns the documentation of the method.
+func (m *Method) Doc() string {
+	return m.doc
+}
+
+// SetDoc sets the documentation of the method.
+func (m *Method) SetDoc(value string) {
+	m.doc = value
+}
+
+// RequestParameters returns the parameters sent by the client in the request.
+func (m *Method) RequestParameters() []*Parameter {
+	return m.request
+}
+
+// AddRequestParameter adds a parameter to the request of the method.
+func (m *Method) AddRequestParameter(parameter *Parameter) {
+	parameter.owner = m
+	m.request = append(m.request, parameter)
+}
+
+// ResponseParameters returns the parameters sent back by the server in the response.
+func (m *Method) ResponseParameters() []*Parameter {
+	return m.response
+}
+
+// AddResponseParameter adds a parameter to the response of the method.
+func (m *Method) AddResponseParameter(parameter *Parameter) {
+	parameter.owner = m
+	m.response = append(m.response, parameter)
+}
+
+// Errors returns the errors that the method has declared it can return. If empty, the method
+// hasn't declared a specific set of errors, and the generic default error response should be used
+// instead.
+func (m *Method) Errors() []*Error {
+	return m.errors
+}
+
+// AddError adds an error to the set of errors that the method can return.
+func (m *Method) AddError(err *Error) {
+	m.errors = append(m.errors, err)
+}
+
+// Multipart returns true if the method has been marked with the `@Multipart` annotation, meaning
+// that its request body is generated as `multipart/form-data`. The `application/json` content is
+// still generated alongside it, unless the body is a single binary parameter.
+func (m *Method) Multipart() bool {
+	return m.multipart
+}
+
+// SetMultipart sets whether the method's request body is `multipart/form-data`.
+func (m *Method) SetMultipart(value bool) {
+	m.multipart = value
+}
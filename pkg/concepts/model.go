@@ -0,0 +1,37 @@
+/*
+Copyright (c) 2019 Red Hat, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+  http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package concepts
+
+// Model represents the complete set of services described by the spec files.
+type Model struct {
+	services []*Service
+}
+
+// NewModel creates a new, empty model.
+func NewModel() *Model {
+	return &Model{}
+}
+
+// Services returns the services contained in the model.
+func (m *Model) Services() []*Service {
+	return m.services
+}
+
+// AddService adds a service to the model.
+func (m *Model) AddService(service *Service) {
+	m.services = append(m.services, service)
+}
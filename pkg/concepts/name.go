@@ -0,0 +1,35 @@
+/*
+Copyright (c) 2019 Red Hat, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+  http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package concepts
+
+// Name represents the name of a model element, as written in the spec files.
+type Name struct {
+	text string
+}
+
+// NewName creates a name from its text representation.
+func NewName(text string) *Name {
+	return &Name{text: text}
+}
+
+// String returns the text representation of the name.
+func (n *Name) String() string {
+	if n == nil {
+		return ""
+	}
+	return n.text
+}
@@ -0,0 +1,165 @@
+/*
+Copyright (c) 2019 Red Hat, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+  http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package concepts
+
+// Parameter represents one of the parameters of a method, either sent by the client in the
+// request or returned by the server in the response.
+type Parameter struct {
+	owner     *Method
+	name      *Name
+	doc       string
+	typ       *Type
+	required  bool
+	format    string
+	min       *float64
+	max       *float64
+	pattern   string
+	minLength *int
+	maxLength *int
+	enum      []string
+}
+
+// NewParameter creates a new parameter with the given name and type.
+func NewParameter(name *Name, typ *Type) *Parameter {
+	return &Parameter{
+		name: name,
+		typ:  typ,
+	}
+}
+
+// Owner returns the method that this parameter belongs to.
+func (p *Parameter) Owner() *Method {
+	return p.owner
+}
+
+// Name returns the name of the parameter.
+func (p *Parameter) Name() *Name {
+	return p.name
+}
+
+// Doc returns the documentation of the parameter.
+func (p *Parameter) Doc() string {
+	return p.doc
+}
+
+// SetDoc sets the documentation of the parameter.
+func (p *Parameter) SetDoc(value string) {
+	p.doc = value
+}
+
+// Type returns the type of the parameter.
+func (p *Parameter) Type() *Type {
+	return p.typ
+}
+
+// Required returns true if the parameter has been marked with the `@Required` annotation.
+func (p *Parameter) Required() bool {
+	return p.required
+}
+
+// SetRequired sets whether the parameter is required.
+func (p *Parameter) SetRequired(value bool) {
+	p.required = value
+}
+
+// Format returns the value of the `@Format` annotation, or the empty string if the parameter
+// doesn't have one.
+func (p *Parameter) Format() string {
+	return p.format
+}
+
+// SetFormat sets the value of the `@Format` annotation.
+func (p *Parameter) SetFormat(value string) {
+	p.format = value
+}
+
+// Min returns the value of the `@Min` annotation and true if the parameter has one, or zero and
+// false otherwise.
+func (p *Parameter) Min() (value float64, ok bool) {
+	if p.min == nil {
+		return 0, false
+	}
+	return *p.min, true
+}
+
+// SetMin sets the value of the `@Min` annotation.
+func (p *Parameter) SetMin(value float64) {
+	p.min = &value
+}
+
+// Max returns the value of the `@Max` annotation and true if the parameter has one, or zero and
+// false otherwise.
+func (p *Parameter) Max() (value float64, ok bool) {
+	if p.max == nil {
+		return 0, false
+	}
+	return *p.max, true
+}
+
+// SetMax sets the value of the `@Max` annotation.
+func (p *Parameter) SetMax(value float64) {
+	p.max = &value
+}
+
+// Pattern returns the value of the `@Pattern` annotation, or the empty string if the parameter
+// doesn't have one.
+func (p *Parameter) Pattern() string {
+	return p.pattern
+}
+
+// SetPattern sets the value of the `@Pattern` annotation.
+func (p *Parameter) SetPattern(value string) {
+	p.pattern = value
+}
+
+// MinLength returns the value of the `@MinLength` annotation and true if the parameter has one, or
+// zero and false otherwise.
+func (p *Parameter) MinLength() (value int, ok bool) {
+	if p.minLength == nil {
+		return 0, false
+	}
+	return *p.minLength, true
+}
+
+// SetMinLength sets the value of the `@MinLength` annotation.
+func (p *Parameter) SetMinLength(value int) {
+	p.minLength = &value
+}
+
+// MaxLength returns the value of the `@MaxLength` annotation and true if the parameter has one, or
+// zero and false otherwise.
+func (p *Parameter) MaxLength() (value int, ok bool) {
+	if p.maxLength == nil {
+		return 0, false
+	}
+	return *p.maxLength, true
+}
+
+// SetMaxLength sets the value of the `@MaxLength` annotation.
+func (p *Parameter) SetMaxLength(value int) {
+	p.maxLength = &value
+}
+
+// Enum returns the values of the `@Enum` annotation, or nil if the parameter doesn't have one.
+func (p *Parameter) Enum() []string {
+	return p.enum
+}
+
+// SetEnum sets the values of the `@Enum` annotation.
+func (p *Parameter) SetEnum(values []string) {
+	p.enum = values
+}
@@ -0,0 +1,58 @@
+/*
+Copyright (c) 2019 Red Hat, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+  http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package concepts
+
+// Resource represents one of the resources of a version, identified by a path, and exposing a set
+// of methods.
+type Resource struct {
+	owner   *Version
+	name    *Name
+	methods []*Method
+}
+
+// NewResource creates a new resource with the given name.
+func NewResource(name *Name) *Resource {
+	return &Resource{
+		name: name,
+	}
+}
+
+// Owner returns the version that this resource belongs to.
+func (r *Resource) Owner() *Version {
+	return r.owner
+}
+
+// SetOwner sets the version that this resource belongs to.
+func (r *Resource) SetOwner(value *Version) {
+	r.owner = value
+}
+
+// Name returns the name of the resource.
+func (r *Resource) Name() *Name {
+	return r.name
+}
+
+// Methods returns the methods of the resource.
+func (r *Resource) Methods() []*Method {
+	return r.methods
+}
+
+// AddMethod adds a method to the resource.
+func (r *Resource) AddMethod(method *Method) {
+	method.owner = r
+	r.methods = append(r.methods, method)
+}
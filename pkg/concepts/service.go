@@ -0,0 +1,60 @@
+/*
+Copyright (c) 2019 Red Hat, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+  http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package concepts
+
+// Service represents one of the services described by the spec files, for example
+// `clusters_mgmt`.
+type Service struct {
+	name     *Name
+	versions []*Version
+	errors   []*Error
+}
+
+// NewService creates a new service with the given name.
+func NewService(name *Name) *Service {
+	return &Service{
+		name: name,
+	}
+}
+
+// Name returns the name of the service.
+func (s *Service) Name() *Name {
+	return s.name
+}
+
+// Versions returns the versions of the service.
+func (s *Service) Versions() []*Version {
+	return s.versions
+}
+
+// AddVersion adds a version to the service.
+func (s *Service) AddVersion(version *Version) {
+	version.owner = s
+	s.versions = append(s.versions, version)
+}
+
+// Errors returns the errors that the service can return, declared independently of any particular
+// method.
+func (s *Service) Errors() []*Error {
+	return s.errors
+}
+
+// AddError adds an error to the set of errors that the service can return.
+func (s *Service) AddError(err *Error) {
+	err.service = s
+	s.errors = append(s.errors, err)
+}
@@ -0,0 +1,194 @@
+/*
+Copyright (c) 2019 Red Hat, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+  http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package concepts
+
+// kind identifies the flavour of a type.
+type kind int
+
+const (
+	kindPrimitive kind = iota
+	kindEnum
+	kindStruct
+	kindClass
+	kindList
+	kindMap
+	kindUnion
+)
+
+// Type represents one of the types of the model: a built-in primitive, an enum, a struct, a class,
+// a list, a map or a sum-type union of other struct/class types.
+type Type struct {
+	owner      *Version
+	name       *Name
+	doc        string
+	kind       kind
+	values     []*EnumValue
+	attributes []*Attribute
+	element    *Type
+	variants   []*Type
+}
+
+// newPrimitiveType creates one of the built-in primitive types of a version. Used only by
+// NewVersion.
+func newPrimitiveType(owner *Version, name *Name) *Type {
+	return &Type{
+		owner: owner,
+		name:  name,
+		kind:  kindPrimitive,
+	}
+}
+
+// NewEnumType creates a new, empty enum type with the given name.
+func NewEnumType(name *Name) *Type {
+	return &Type{
+		name: name,
+		kind: kindEnum,
+	}
+}
+
+// NewStructType creates a new, empty struct type with the given name.
+func NewStructType(name *Name) *Type {
+	return &Type{
+		name: name,
+		kind: kindStruct,
+	}
+}
+
+// NewClassType creates a new, empty class type with the given name.
+func NewClassType(name *Name) *Type {
+	return &Type{
+		name: name,
+		kind: kindClass,
+	}
+}
+
+// NewListType creates a new list type with the given element type.
+func NewListType(owner *Version, element *Type) *Type {
+	return &Type{
+		owner:   owner,
+		kind:    kindList,
+		element: element,
+	}
+}
+
+// NewMapType creates a new map type with the given element type.
+func NewMapType(owner *Version, element *Type) *Type {
+	return &Type{
+		owner:   owner,
+		kind:    kindMap,
+		element: element,
+	}
+}
+
+// NewUnionType creates a new, empty sum-type union with the given name. A union doesn't have its
+// own attributes; instead it is one of a fixed set of struct or class variants, discriminated by
+// the `kind` of whichever variant is actually present.
+func NewUnionType(name *Name) *Type {
+	return &Type{
+		name: name,
+		kind: kindUnion,
+	}
+}
+
+// Owner returns the version that this type belongs to.
+func (t *Type) Owner() *Version {
+	return t.owner
+}
+
+// Name returns the name of the type.
+func (t *Type) Name() *Name {
+	return t.name
+}
+
+// Doc returns the documentation of the type.
+func (t *Type) Doc() string {
+	return t.doc
+}
+
+// SetDoc sets the documentation of the type.
+func (t *Type) SetDoc(value string) {
+	t.doc = value
+}
+
+// IsEnum returns true if this is an enum type.
+func (t *Type) IsEnum() bool {
+	return t.kind == kindEnum
+}
+
+// IsStruct returns true if this is a struct or a class, as classes are structs that additionally
+// carry `kind`, `id` and `href` attributes.
+func (t *Type) IsStruct() bool {
+	return t.kind == kindStruct || t.kind == kindClass
+}
+
+// IsClass returns true if this is a class, i.e. a struct that also has an identifier and can be
+// referenced by a link.
+func (t *Type) IsClass() bool {
+	return t.kind == kindClass
+}
+
+// IsList returns true if this is a list type.
+func (t *Type) IsList() bool {
+	return t.kind == kindList
+}
+
+// IsMap returns true if this is a map type.
+func (t *Type) IsMap() bool {
+	return t.kind == kindMap
+}
+
+// IsUnion returns true if this is a sum-type union of other struct or class types.
+func (t *Type) IsUnion() bool {
+	return t.kind == kindUnion
+}
+
+// Values returns the values of an enum type.
+func (t *Type) Values() []*EnumValue {
+	return t.values
+}
+
+// AddValue adds a value to an enum type.
+func (t *Type) AddValue(value *EnumValue) {
+	value.owner = t
+	t.values = append(t.values, value)
+}
+
+// Attributes returns the attributes of a struct or class type.
+func (t *Type) Attributes() []*Attribute {
+	return t.attributes
+}
+
+// AddAttribute adds an attribute to a struct or class type.
+func (t *Type) AddAttribute(attribute *Attribute) {
+	attribute.owner = t
+	t.attributes = append(t.attributes, attribute)
+}
+
+// Element returns the element type of a list or map type.
+func (t *Type) Element() *Type {
+	return t.element
+}
+
+// Variants returns the struct or class types that make up a union type.
+func (t *Type) Variants() []*Type {
+	return t.variants
+}
+
+// AddVariant adds a variant to a union type.
+func (t *Type) AddVariant(variant *Type) {
+	t.variants = append(t.variants, variant)
+}
@@ -0,0 +1,126 @@
+/*
+Copyright (c) 2019 Red Hat, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+  http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package concepts
+
+// Version represents one of the versions of a service, for example `v1`.
+type Version struct {
+	owner   *Service
+	name    *Name
+	doc     string
+	types   []*Type
+	paths   [][]*Locator
+	boolean *Type
+	integer *Type
+	long    *Type
+	float   *Type
+	string  *Type
+	date    *Type
+	binary  *Type
+}
+
+// NewVersion creates a new version with the given name, already populated with the built-in
+// primitive types (boolean, integer, long, float, string, date and binary).
+func NewVersion(name *Name) *Version {
+	version := &Version{
+		name: name,
+	}
+	version.boolean = newPrimitiveType(version, NewName("boolean"))
+	version.integer = newPrimitiveType(version, NewName("integer"))
+	version.long = newPrimitiveType(version, NewName("long"))
+	version.float = newPrimitiveType(version, NewName("float"))
+	version.string = newPrimitiveType(version, NewName("string"))
+	version.date = newPrimitiveType(version, NewName("date"))
+	version.binary = newPrimitiveType(version, NewName("binary"))
+	return version
+}
+
+// Owner returns the service that this version belongs to.
+func (v *Version) Owner() *Service {
+	return v.owner
+}
+
+// Name returns the name of the version.
+func (v *Version) Name() *Name {
+	return v.name
+}
+
+// Doc returns the documentation of the version.
+func (v *Version) Doc() string {
+	return v.doc
+}
+
+// SetDoc sets the documentation of the version.
+func (v *Version) SetDoc(value string) {
+	v.doc = value
+}
+
+// Types returns the types defined in the version.
+func (v *Version) Types() []*Type {
+	return v.types
+}
+
+// AddType adds a type to the version.
+func (v *Version) AddType(typ *Type) {
+	typ.owner = v
+	v.types = append(v.types, typ)
+}
+
+// Paths returns the paths, each expressed as the sequence of locators leading from the root of the
+// version to the resource that the path identifies, declared in the version.
+func (v *Version) Paths() [][]*Locator {
+	return v.paths
+}
+
+// AddPath adds a path to the version.
+func (v *Version) AddPath(path []*Locator) {
+	v.paths = append(v.paths, path)
+}
+
+// Boolean returns the built-in boolean type of the version.
+func (v *Version) Boolean() *Type {
+	return v.boolean
+}
+
+// Integer returns the built-in integer type of the version.
+func (v *Version) Integer() *Type {
+	return v.integer
+}
+
+// Long returns the built-in long integer type of the version.
+func (v *Version) Long() *Type {
+	return v.long
+}
+
+// Float returns the built-in floating point type of the version.
+func (v *Version) Float() *Type {
+	return v.float
+}
+
+// String returns the built-in string type of the version.
+func (v *Version) String() *Type {
+	return v.string
+}
+
+// Date returns the built-in date type of the version.
+func (v *Version) Date() *Type {
+	return v.date
+}
+
+// Binary returns the built-in binary type of the version, used for file uploads and downloads.
+func (v *Version) Binary() *Type {
+	return v.binary
+}
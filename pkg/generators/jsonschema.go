@@ -0,0 +1,332 @@
+/*
+Copyright (c) 2019 Red Hat, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+  http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package generators
+
+import (
+	"fmt"
+
+	"github.com/openshift-online/ocm-api-metamodel/pkg/concepts"
+	"github.com/openshift-online/ocm-api-metamodel/pkg/golang"
+	"github.com/openshift-online/ocm-api-metamodel/pkg/openapi"
+	"github.com/openshift-online/ocm-api-metamodel/pkg/reporter"
+)
+
+// defaultJSONSchemaBaseURL is the base URL used to calculate the `$id` of the generated
+// documents when no other base URL has been configured.
+const defaultJSONSchemaBaseURL = "https://api.openshift.com/schemas"
+
+// JSONSchemaGeneratorBuilder is an object used to configure and build the JSON Schema document
+// generator. Don't create instances directly, use the NewJSONSchemaGenerator function instead.
+type JSONSchemaGeneratorBuilder struct {
+	reporter *reporter.Reporter
+	model    *concepts.Model
+	output   string
+	names    *openapi.NamesCalculator
+	packages *golang.PackagesCalculator
+	baseURL  string
+}
+
+// JSONSchemaGenerator generates standalone JSON Schema Draft 2020-12 documents for the model, one
+// per version, with every struct and enum of that version under `$defs`. Unlike the OpenAPI
+// generator, which embeds schemas inside a larger document, this produces a document that is
+// itself a valid JSON Schema and can be fed straight to a generic validator.
+type JSONSchemaGenerator struct {
+	reporter *reporter.Reporter
+	errors   int
+	model    *concepts.Model
+	output   string
+	names    *openapi.NamesCalculator
+	packages *golang.PackagesCalculator
+	buffer   *openapi.Buffer
+	baseURL  string
+}
+
+// NewJSONSchemaGenerator creates a new builder for JSON Schema document generators.
+func NewJSONSchemaGenerator() *JSONSchemaGeneratorBuilder {
+	return &JSONSchemaGeneratorBuilder{}
+}
+
+// Reporter sets the object that will be used to report information about the generation process,
+// including errors.
+func (b *JSONSchemaGeneratorBuilder) Reporter(value *reporter.Reporter) *JSONSchemaGeneratorBuilder {
+	b.reporter = value
+	return b
+}
+
+// Model sets the model that will be used by the generator.
+func (b *JSONSchemaGeneratorBuilder) Model(value *concepts.Model) *JSONSchemaGeneratorBuilder {
+	b.model = value
+	return b
+}
+
+// Output sets the output directory.
+func (b *JSONSchemaGeneratorBuilder) Output(value string) *JSONSchemaGeneratorBuilder {
+	b.output = value
+	return b
+}
+
+// Names sets calculator that will be used to calculate names of schema things.
+func (b *JSONSchemaGeneratorBuilder) Names(value *openapi.NamesCalculator) *JSONSchemaGeneratorBuilder {
+	b.names = value
+	return b
+}
+
+// Packages sets the object that will by used to calculate Go package names.
+func (b *JSONSchemaGeneratorBuilder) Packages(
+	value *golang.PackagesCalculator) *JSONSchemaGeneratorBuilder {
+	b.packages = value
+	return b
+}
+
+// BaseURL sets the base URL used to calculate the `$id` of the generated documents, for example
+// `https://api.openshift.com/schemas`. This is optional, and defaults to that same value.
+func (b *JSONSchemaGeneratorBuilder) BaseURL(value string) *JSONSchemaGeneratorBuilder {
+	b.baseURL = value
+	return b
+}
+
+// Build checks the configuration stored in the builder and, if it is correct, creates a new JSON
+// Schema document generator using it.
+func (b *JSONSchemaGeneratorBuilder) Build() (generator *JSONSchemaGenerator, err error) {
+	// Check that the mandatory parameters have been provided:
+	if b.reporter == nil {
+		err = fmt.Errorf("reporter is mandatory")
+		return
+	}
+	if b.model == nil {
+		err = fmt.Errorf("model is mandatory")
+		return
+	}
+	if b.output == "" {
+		err = fmt.Errorf("output directory is mandatory")
+		return
+	}
+	if b.names == nil {
+		err = fmt.Errorf("names calculator is mandatory")
+		return
+	}
+	if b.packages == nil {
+		err = fmt.Errorf("packages calculator is mandatory")
+		return
+	}
+
+	// Apply defaults:
+	baseURL := b.baseURL
+	if baseURL == "" {
+		baseURL = defaultJSONSchemaBaseURL
+	}
+
+	// Create the generator:
+	generator = &JSONSchemaGenerator{
+		reporter: b.reporter,
+		model:    b.model,
+		output:   b.output,
+		names:    b.names,
+		packages: b.packages,
+		baseURL:  baseURL,
+	}
+
+	return
+}
+
+// Run executes the code generator.
+func (g *JSONSchemaGenerator) Run() error {
+	var err error
+
+	// Generate the JSON Schema document for each version:
+	for _, service := range g.model.Services() {
+		for _, version := range service.Versions() {
+			err = g.generateSpec(version)
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	// Check if there were errors:
+	if g.errors > 0 {
+		if g.errors > 1 {
+			err = fmt.Errorf("there were %d errors", g.errors)
+		} else {
+			err = fmt.Errorf("there was 1 error")
+		}
+		return err
+	}
+
+	return nil
+}
+
+func (g *JSONSchemaGenerator) generateSpec(version *concepts.Version) error {
+	var err error
+
+	// Calculate the package name:
+	pkgName := g.packages.VersionPackage(version)
+
+	// Create the buffer:
+	g.buffer, err = openapi.NewBufferBuilder().
+		Reporter(g.reporter).
+		Output(g.output).
+		Packages(g.packages).
+		Package(pkgName).
+		Build()
+	if err != nil {
+		return err
+	}
+
+	// Generate the source:
+	g.generateSpecSource(version)
+
+	// Write the generated code:
+	return g.buffer.Write()
+}
+
+func (g *JSONSchemaGenerator) generateSpecSource(version *concepts.Version) {
+	g.buffer.StartObject()
+	g.buffer.Field("$schema", "https://json-schema.org/draft/2020-12/schema")
+	g.buffer.Field("$id", g.schemaID(version))
+	g.buffer.Field("title", version.Owner().Name().String())
+	g.generateDescription(version.Doc())
+	g.buffer.StartObject("$defs")
+	for _, typ := range version.Types() {
+		g.generateSchema(typ)
+	}
+	g.buffer.EndObject()
+	g.buffer.EndObject()
+}
+
+// schemaID calculates the stable URI used as the `$id` of the document generated for a version,
+// for example `https://api.openshift.com/schemas/clusters_mgmt/v1`.
+func (g *JSONSchemaGenerator) schemaID(version *concepts.Version) string {
+	service := version.Owner()
+	return fmt.Sprintf("%s/%s/%s", g.baseURL, service.Name(), version.Name())
+}
+
+func (g *JSONSchemaGenerator) generateSchema(typ *concepts.Type) {
+	switch {
+	case typ.IsEnum():
+		g.generateEnumSchema(typ)
+	case typ.IsStruct():
+		g.generateStructSchema(typ)
+	}
+}
+
+func (g *JSONSchemaGenerator) generateEnumSchema(typ *concepts.Type) {
+	name := g.names.SchemaName(typ)
+	g.buffer.StartObject(name)
+	g.buffer.Field("title", name)
+	g.generateDescription(typ.Doc())
+	g.buffer.Field("type", "string")
+	g.buffer.StartArray("enum")
+	for _, value := range typ.Values() {
+		g.buffer.Item(value.Name().String())
+	}
+	g.buffer.EndArray()
+	g.buffer.EndObject()
+}
+
+func (g *JSONSchemaGenerator) generateStructSchema(typ *concepts.Type) {
+	name := g.names.SchemaName(typ)
+	g.buffer.StartObject(name)
+	g.buffer.Field("title", name)
+	g.generateDescription(typ.Doc())
+	g.buffer.Field("type", "object")
+	g.buffer.StartObject("properties")
+	if typ.IsClass() {
+		// Kind:
+		g.buffer.StartObject("kind")
+		g.generateDescription(fmt.Sprintf(
+			"Indicates the type of this object. Will be '%s' if this is a complete "+
+				"object or '%sLink' if it is just a link.",
+			name, name,
+		))
+		g.buffer.Field("type", "string")
+		g.buffer.Field("x-ocm-kind", name)
+		g.buffer.EndObject()
+
+		// ID:
+		g.buffer.StartObject("id")
+		g.generateDescription("Unique identifier of the object.")
+		g.buffer.Field("type", "string")
+		g.buffer.Field("readOnly", true)
+		g.buffer.EndObject()
+
+		// HREF:
+		g.buffer.StartObject("href")
+		g.generateDescription("Self link.")
+		g.buffer.Field("type", "string")
+		g.buffer.Field("readOnly", true)
+		g.buffer.EndObject()
+	}
+	for _, attribute := range typ.Attributes() {
+		g.generateStructProperty(attribute)
+	}
+	g.buffer.EndObject()
+	g.buffer.EndObject()
+}
+
+func (g *JSONSchemaGenerator) generateStructProperty(attribute *concepts.Attribute) {
+	name := g.names.AttributePropertyName(attribute)
+	g.buffer.StartObject(name)
+	g.generateDescription(attribute.Doc())
+	g.generateSchemaReference(attribute.Type())
+	g.buffer.EndObject()
+}
+
+func (g *JSONSchemaGenerator) generateSchemaReference(typ *concepts.Type) {
+	version := typ.Owner()
+	switch {
+	case typ == version.Boolean():
+		g.buffer.Field("type", "boolean")
+	case typ == version.Integer():
+		g.buffer.Field("type", "integer")
+	case typ == version.Long():
+		g.buffer.Field("type", "integer")
+	case typ == version.Float():
+		g.buffer.Field("type", "number")
+	case typ == version.String():
+		g.buffer.Field("type", "string")
+	case typ == version.Date():
+		g.buffer.Field("type", "string")
+		g.buffer.Field("format", "date-time")
+	case typ == version.Binary():
+		g.buffer.Field("type", "string")
+		g.buffer.Field("contentEncoding", "base64")
+	case typ.IsEnum() || typ.IsStruct():
+		g.buffer.Field("$ref", "#/$defs/"+g.names.SchemaName(typ))
+	case typ.IsList():
+		g.buffer.Field("type", "array")
+		g.buffer.StartObject("items")
+		g.generateSchemaReference(typ.Element())
+		g.buffer.EndObject()
+	case typ.IsMap():
+		g.buffer.Field("type", "object")
+		g.buffer.StartObject("additionalProperties")
+		g.generateSchemaReference(typ.Element())
+		g.buffer.EndObject()
+	default:
+		g.reporter.Errorf(
+			"Don't know how to generate schema reference for type '%s'",
+			typ.Name(),
+		)
+	}
+}
+
+func (g *JSONSchemaGenerator) generateDescription(doc string) {
+	if doc != "" {
+		g.buffer.Field("description", doc)
+	}
+}
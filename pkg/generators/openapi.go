@@ -19,6 +19,7 @@ package generators
 import (
 	"fmt"
 	"sort"
+	"strconv"
 	"strings"
 
 	"github.com/openshift-online/ocm-api-metamodel/pkg/concepts"
@@ -28,27 +29,67 @@ import (
 	"github.com/openshift-online/ocm-api-metamodel/pkg/reporter"
 )
 
+// SpecVersion represents a target OpenAPI specification version that the generator knows how to
+// produce.
+type SpecVersion string
+
+const (
+	// SpecVersion300 generates OpenAPI 3.0.0 documents. This is the default.
+	SpecVersion300 SpecVersion = "3.0.0"
+
+	// SpecVersion303 generates OpenAPI 3.0.3 documents.
+	SpecVersion303 SpecVersion = "3.0.3"
+
+	// SpecVersion310 generates OpenAPI 3.1.0 documents, aligned with JSON Schema 2020-12.
+	SpecVersion310 SpecVersion = "3.1.0"
+)
+
+// Mode controls how the OpenAPI output for a version is laid out on disk.
+type Mode string
+
+const (
+	// ModeBundled writes a single document per version containing every path and schema. This
+	// is the default, and matches the historical behaviour of the generator.
+	ModeBundled Mode = "bundled"
+
+	// ModeSplitByResource writes one document per top-level resource, plus a shared
+	// `_components` document with the schemas, so that large services don't end up as a single
+	// megabyte-scale file.
+	ModeSplitByResource Mode = "split-by-resource"
+
+	// ModeSplitByTag writes one document per leaf resource in the hierarchy, plus a shared
+	// `_components` document with the schemas. This gives finer-grained files than
+	// ModeSplitByResource.
+	ModeSplitByTag Mode = "split-by-tag"
+)
+
 // OpenAPIGeneratorBuilder is an object used to configure and build the OpenAPI specifications
 // generator. Don't create instances directly, use the NewOpenAPIGenerator function instead.
 type OpenAPIGeneratorBuilder struct {
-	reporter *reporter.Reporter
-	model    *concepts.Model
-	output   string
-	names    *openapi.NamesCalculator
-	binding  *http.BindingCalculator
-	packages *golang.PackagesCalculator
+	reporter    *reporter.Reporter
+	model       *concepts.Model
+	output      string
+	names       *openapi.NamesCalculator
+	binding     *http.BindingCalculator
+	packages    *golang.PackagesCalculator
+	specVersion SpecVersion
+	mode        Mode
+	yaml        bool
 }
 
 // OpenAPIGenerator generates OpenAPI specifications for the model.
 type OpenAPIGenerator struct {
-	reporter *reporter.Reporter
-	errors   int
-	model    *concepts.Model
-	output   string
-	names    *openapi.NamesCalculator
-	binding  *http.BindingCalculator
-	packages *golang.PackagesCalculator
-	buffer   *openapi.Buffer
+	reporter    *reporter.Reporter
+	errors      int
+	model       *concepts.Model
+	output      string
+	names       *openapi.NamesCalculator
+	binding     *http.BindingCalculator
+	packages    *golang.PackagesCalculator
+	buffer      *openapi.Buffer
+	specVersion SpecVersion
+	mode        Mode
+	yaml        bool
 }
 
 // NewOpenAPIGenerator creates a new builder for OpenAPI specification generators.
@@ -94,6 +135,27 @@ func (b *OpenAPIGeneratorBuilder) Packages(
 	return b
 }
 
+// SpecVersion sets the target OpenAPI specification version that will be generated. If not
+// explicitly set it defaults to SpecVersion300.
+func (b *OpenAPIGeneratorBuilder) SpecVersion(value SpecVersion) *OpenAPIGeneratorBuilder {
+	b.specVersion = value
+	return b
+}
+
+// Mode sets how the output will be laid out on disk. If not explicitly set it defaults to
+// ModeBundled.
+func (b *OpenAPIGeneratorBuilder) Mode(value Mode) *OpenAPIGeneratorBuilder {
+	b.mode = value
+	return b
+}
+
+// YAML enables, in addition to the JSON document(s) that are always generated, an equivalent YAML
+// document for each of them.
+func (b *OpenAPIGeneratorBuilder) YAML(value bool) *OpenAPIGeneratorBuilder {
+	b.yaml = value
+	return b
+}
+
 // Build checks the configuration stored in the builder and, if it is correct, creates a new
 // OpenAPI specifications generator using it.
 func (b *OpenAPIGeneratorBuilder) Build() (generator *OpenAPIGenerator, err error) {
@@ -123,14 +185,39 @@ func (b *OpenAPIGeneratorBuilder) Build() (generator *OpenAPIGenerator, err erro
 		return
 	}
 
+	// Apply defaults:
+	specVersion := b.specVersion
+	switch specVersion {
+	case "":
+		specVersion = SpecVersion300
+	case SpecVersion300, SpecVersion303, SpecVersion310:
+		// Valid, nothing to do.
+	default:
+		err = fmt.Errorf("unknown spec version '%s'", specVersion)
+		return
+	}
+	mode := b.mode
+	switch mode {
+	case "":
+		mode = ModeBundled
+	case ModeBundled, ModeSplitByResource, ModeSplitByTag:
+		// Valid, nothing to do.
+	default:
+		err = fmt.Errorf("unknown mode '%s'", mode)
+		return
+	}
+
 	// Create the generator:
 	generator = &OpenAPIGenerator{
-		reporter: b.reporter,
-		model:    b.model,
-		output:   b.output,
-		names:    b.names,
-		binding:  b.binding,
-		packages: b.packages,
+		reporter:    b.reporter,
+		model:       b.model,
+		output:      b.output,
+		names:       b.names,
+		binding:     b.binding,
+		packages:    b.packages,
+		specVersion: specVersion,
+		mode:        mode,
+		yaml:        b.yaml,
 	}
 
 	return
@@ -164,40 +251,101 @@ func (g *OpenAPIGenerator) Run() error {
 }
 
 func (g *OpenAPIGenerator) generateSpec(version *concepts.Version) error {
-	var err error
-
-	// Calculate the package name:
 	pkgName := g.packages.VersionPackage(version)
+	if g.mode == ModeBundled {
+		return g.writeDocument(pkgName, "", func() {
+			g.generateSpecSource(version, g.allPaths(version))
+		})
+	}
+	return g.generateSplitSpec(version, pkgName)
+}
 
-	// Create the buffer:
-	g.buffer, err = openapi.NewBufferBuilder().
-		Reporter(g.reporter).
-		Output(g.output).
-		Packages(g.packages).
-		Package(pkgName).
-		Build()
+// generateSplitSpec writes the shared `_components` document followed by one document per group
+// (top-level resource or leaf resource, depending on the configured mode).
+func (g *OpenAPIGenerator) generateSplitSpec(version *concepts.Version, pkgName string) error {
+	err := g.writeDocument(pkgName, "components", func() {
+		g.buffer.StartObject()
+		g.generateComponents(version)
+		g.buffer.EndObject()
+	})
 	if err != nil {
 		return err
 	}
 
-	// Generate the source:
-	g.generateSpecSource(version)
+	groups := g.groupPaths(version)
+	names := make([]string, 0, len(groups))
+	for name := range groups {
+		names = append(names, name)
+	}
+	sort.Strings(names)
 
-	// Write the generated code:
-	return g.buffer.Write()
+	for _, name := range names {
+		entries := groups[name]
+		err = g.writeDocument(pkgName, name, func() {
+			g.generateSpecSource(version, entries)
+		})
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
 }
 
-func (g *OpenAPIGenerator) generateSpecSource(version *concepts.Version) {
+// writeDocument creates the buffer(s) needed for one logical document (the bundled document, the
+// shared components document, or one group document), runs the given function to populate it,
+// and writes it to disk. When YAML output has been requested it repeats the process for a YAML
+// buffer of the same document.
+func (g *OpenAPIGenerator) writeDocument(pkgName, suffix string, generate func()) error {
+	formats := []openapi.Format{openapi.FormatJSON}
+	if g.yaml {
+		formats = append(formats, openapi.FormatYAML)
+	}
+	for _, format := range formats {
+		builder := openapi.NewBufferBuilder().
+			Reporter(g.reporter).
+			Output(g.output).
+			Packages(g.packages).
+			Package(pkgName).
+			Format(format)
+		if suffix != "" {
+			builder = builder.Suffix(suffix)
+		}
+		buffer, err := builder.Build()
+		if err != nil {
+			return err
+		}
+		g.buffer = buffer
+		generate()
+		err = g.buffer.Write()
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (g *OpenAPIGenerator) generateSpecSource(version *concepts.Version, entries []*pathEntry) {
 	g.buffer.StartObject()
-	g.buffer.Field("openapi", "3.0.0")
+	g.buffer.Field("openapi", string(g.specVersion))
+	if g.is31() {
+		g.buffer.Field("jsonSchemaDialect", "https://json-schema.org/draft/2020-12/schema")
+	}
 	g.generateInfo(version)
 	g.generateServers(version)
-	g.generatePaths(version)
-	g.generateComponents(version)
+	g.generatePaths(entries)
+	if g.mode == ModeBundled {
+		g.generateComponents(version)
+	}
 	g.generateSecurity(version)
 	g.buffer.EndObject()
 }
 
+// is31 returns true if the generator has been configured to produce OpenAPI 3.1.0 documents.
+func (g *OpenAPIGenerator) is31() bool {
+	return g.specVersion == SpecVersion310
+}
+
 func (g *OpenAPIGenerator) generateInfo(version *concepts.Version) {
 	g.buffer.StartObject("info")
 	g.buffer.Field("version", version.Name().String())
@@ -222,9 +370,16 @@ func (g *OpenAPIGenerator) generateServers(version *concepts.Version) {
 	g.buffer.EndArray()
 }
 
-func (g *OpenAPIGenerator) generatePaths(version *concepts.Version) {
-	// Calculate the complete URLs for the paths and sort them alphabetically so the order will
-	// be predictable:
+// pathEntry pairs a path with its already calculated absolute URL, so that it can be sorted and
+// grouped without recalculating the URL over and over.
+type pathEntry struct {
+	absolute string
+	path     []*concepts.Locator
+}
+
+// allPaths calculates the complete URLs for all the paths of a version and sorts them
+// alphabetically so that the order in the generated documents will be predictable.
+func (g *OpenAPIGenerator) allPaths(version *concepts.Version) []*pathEntry {
 	index := map[string][]*concepts.Locator{}
 	for _, path := range version.Paths() {
 		absolute := g.absolutePath(path)
@@ -238,11 +393,39 @@ func (g *OpenAPIGenerator) generatePaths(version *concepts.Version) {
 	}
 	sort.Strings(absolutes)
 
-	// Generate the specification:
+	entries := make([]*pathEntry, len(absolutes))
+	for i, absolute := range absolutes {
+		entries[i] = &pathEntry{absolute: absolute, path: index[absolute]}
+	}
+	return entries
+}
+
+// groupPaths splits the paths of a version into the groups used by the split modes, keyed by
+// group name.
+func (g *OpenAPIGenerator) groupPaths(version *concepts.Version) map[string][]*pathEntry {
+	groups := map[string][]*pathEntry{}
+	for _, entry := range g.allPaths(version) {
+		name := g.groupKey(entry.path)
+		groups[name] = append(groups[name], entry)
+	}
+	return groups
+}
+
+// groupKey calculates the name of the group (file) that a path belongs to, depending on the
+// configured mode: the name of the top-level resource for ModeSplitByResource, or the name of the
+// leaf resource for the finer-grained ModeSplitByTag.
+func (g *OpenAPIGenerator) groupKey(path []*concepts.Locator) string {
+	if g.mode == ModeSplitByTag {
+		resource := path[len(path)-1].Target()
+		return resource.Name().String()
+	}
+	return path[0].Name().String()
+}
+
+func (g *OpenAPIGenerator) generatePaths(entries []*pathEntry) {
 	g.buffer.StartObject("paths")
-	for _, absolute := range absolutes {
-		path := index[absolute]
-		g.generatePath(path)
+	for _, entry := range entries {
+		g.generatePath(entry.path)
 	}
 	g.buffer.EndObject()
 }
@@ -264,7 +447,7 @@ func (g *OpenAPIGenerator) generateMethod(path []*concepts.Locator, method *conc
 	bodyParameters := g.binding.RequestBodyParameters(method)
 	if len(bodyParameters) > 0 {
 		bodyParameter := bodyParameters[0]
-		g.generateRequestBody(bodyParameter)
+		g.generateRequestBody(method, bodyParameter)
 	}
 	g.generateResponses(path, method)
 	g.buffer.EndObject()
@@ -298,24 +481,68 @@ func (g *OpenAPIGenerator) generateQueryParameter(parameter *concepts.Parameter)
 	g.buffer.Field("name", g.binding.ParameterName(parameter))
 	g.generateDescription(parameter.Doc())
 	g.buffer.Field("in", "query")
+	if parameter.Required() {
+		g.buffer.Field("required", true)
+	}
 	g.buffer.StartObject("schema")
-	g.generateSchemaReference(parameter.Type())
+	g.generateNullableSchemaReference(parameter.Type(), false, parameter.Format())
+	g.generateConstraints(parameter)
 	g.buffer.EndObject()
 	g.buffer.EndObject()
 }
 
-func (g *OpenAPIGenerator) generateRequestBody(parameter *concepts.Parameter) {
+// Note: this only covers the OpenAPI side of multipart/binary support. The request that added
+// Method.Multipart and Version.Binary also called for threading both through the Go client/server
+// generators, but this checkout has no such generators at all (pkg/generators only contains this
+// OpenAPI generator and the JSON Schema one), so there's nothing to thread them into yet.
+func (g *OpenAPIGenerator) generateRequestBody(method *concepts.Method, parameter *concepts.Parameter) {
+	version := parameter.Type().Owner()
+	pureUpload := method.Multipart() && parameter.Type() == version.Binary()
 	g.buffer.StartObject("requestBody")
 	g.buffer.StartObject("content")
-	g.buffer.StartObject("application/json")
-	g.buffer.StartObject("schema")
-	g.generateSchemaReference(parameter.Type())
-	g.buffer.EndObject()
-	g.buffer.EndObject()
+	if method.Multipart() {
+		g.buffer.StartObject("multipart/form-data")
+		g.buffer.StartObject("schema")
+		g.generateMultipartSchema(parameter.Type())
+		g.buffer.EndObject()
+		g.buffer.EndObject()
+	}
+	if !pureUpload {
+		g.buffer.StartObject("application/json")
+		g.buffer.StartObject("schema")
+		g.generateSchemaReference(parameter.Type())
+		g.buffer.EndObject()
+		g.buffer.EndObject()
+	}
 	g.buffer.EndObject()
 	g.buffer.EndObject()
 }
 
+// generateMultipartSchema generates the schema used for a `multipart/form-data` request body.
+// Binary attributes become file parts (`type: string` with the encoding chosen by
+// generateBinaryType: `format: binary` in 3.0.x mode, `contentEncoding: base64` in 3.1.0 mode);
+// every other attribute is emitted as a regular form field using the usual schema reference rules.
+func (g *OpenAPIGenerator) generateMultipartSchema(typ *concepts.Type) {
+	version := typ.Owner()
+	switch {
+	case typ == version.Binary():
+		g.generateBinaryType(false)
+	case typ.IsStruct():
+		g.buffer.Field("type", "object")
+		g.buffer.StartObject("properties")
+		for _, attribute := range typ.Attributes() {
+			name := g.names.AttributePropertyName(attribute)
+			g.buffer.StartObject(name)
+			g.generateDescription(attribute.Doc())
+			g.generateMultipartSchema(attribute.Type())
+			g.buffer.EndObject()
+		}
+		g.buffer.EndObject()
+	default:
+		g.generateSchemaReference(typ)
+	}
+}
+
 func (g *OpenAPIGenerator) generateResponses(path []*concepts.Locator, method *concepts.Method) {
 	g.buffer.StartObject("responses")
 	g.buffer.StartObject(g.binding.DefaultStatus(method))
@@ -340,15 +567,44 @@ func (g *OpenAPIGenerator) generateResponses(path []*concepts.Locator, method *c
 		g.buffer.EndObject()
 	}
 	g.buffer.EndObject()
+	methodErrors := method.Errors()
+	if len(methodErrors) == 0 {
+		g.generateDefaultErrorResponse()
+	} else {
+		for _, methodError := range methodErrors {
+			g.generateErrorResponse(methodError)
+		}
+	}
+	g.buffer.EndObject()
+}
+
+// generateDefaultErrorResponse generates the catch-all `default` error response, used for methods
+// that haven't declared the specific set of errors they can return.
+func (g *OpenAPIGenerator) generateDefaultErrorResponse() {
 	g.buffer.StartObject("default")
 	g.generateDescription("Error.")
 	g.buffer.StartObject("content")
 	g.buffer.StartObject("application/json")
 	g.buffer.StartObject("schema")
-	g.buffer.Field("$ref", "#/components/schemas/Error")
+	g.buffer.Field("$ref", g.schemaRef("Error"))
+	g.buffer.EndObject()
 	g.buffer.EndObject()
 	g.buffer.EndObject()
 	g.buffer.EndObject()
+}
+
+// generateErrorResponse generates the response object for one of the errors that a method has
+// declared it can return, keyed by its HTTP status code and referencing the error-specific
+// schema instead of the generic `Error` schema.
+func (g *OpenAPIGenerator) generateErrorResponse(methodError *concepts.Error) {
+	g.buffer.StartObject(strconv.Itoa(methodError.Status()))
+	g.generateDescription(methodError.Doc())
+	g.buffer.StartObject("content")
+	g.buffer.StartObject("application/json")
+	g.buffer.StartObject("schema")
+	g.buffer.Field("$ref", g.schemaRef(g.errorSchemaName(methodError)))
+	g.buffer.EndObject()
+	g.buffer.EndObject()
 	g.buffer.EndObject()
 	g.buffer.EndObject()
 }
@@ -361,6 +617,16 @@ func (g *OpenAPIGenerator) generateResponseProperty(parameter *concepts.Paramete
 	g.buffer.EndObject()
 }
 
+// schemaRef calculates the `$ref` used to point at a schema with the given name. In ModeBundled
+// the schema lives in the same document, so a local reference is enough. In the split modes it
+// lives in the shared `_components` document, so an external reference is used instead.
+func (g *OpenAPIGenerator) schemaRef(name string) string {
+	if g.mode == ModeBundled {
+		return "#/components/schemas/" + name
+	}
+	return "./_components.json#/components/schemas/" + name
+}
+
 func (g *OpenAPIGenerator) generateComponents(version *concepts.Version) {
 	g.buffer.StartObject("components")
 	g.buffer.StartObject("schemas")
@@ -368,6 +634,9 @@ func (g *OpenAPIGenerator) generateComponents(version *concepts.Version) {
 		g.generateSchema(typ)
 	}
 	g.generateErrorSchema()
+	for _, serviceError := range version.Owner().Errors() {
+		g.generateErrorCodeSchema(serviceError)
+	}
 	g.buffer.EndObject()
 	g.buffer.EndObject()
 }
@@ -378,6 +647,8 @@ func (g *OpenAPIGenerator) generateSchema(typ *concepts.Type) {
 		g.generateEnumSchema(typ)
 	case typ.IsStruct():
 		g.generateStructSchema(typ)
+	case typ.IsUnion():
+		g.generateUnionSchema(typ)
 	}
 }
 
@@ -396,8 +667,81 @@ func (g *OpenAPIGenerator) generateEnumSchema(typ *concepts.Type) {
 
 func (g *OpenAPIGenerator) generateStructSchema(typ *concepts.Type) {
 	name := g.names.SchemaName(typ)
+	if typ.IsClass() {
+		g.generateClassSchema(typ, name)
+		return
+	}
+	g.generatePlainStructSchema(typ, name, name)
+}
+
+// generateClassSchema generates the three schemas used to represent a class: the public schema
+// (for example `Cluster`), which is a `discriminator`/`oneOf` wrapper of the full representation
+// and the link representation; the full representation itself (`ClusterData`); and the
+// automatically derived link representation (`ClusterLink`). This mirrors how OCM actually
+// serializes objects on the wire, where any reference to a class can be either the complete
+// object or just a link to it.
+func (g *OpenAPIGenerator) generateClassSchema(typ *concepts.Type, name string) {
+	dataName := name + "Data"
+	linkName := name + "Link"
+
 	g.buffer.StartObject(name)
 	g.generateDescription(typ.Doc())
+	g.buffer.StartArray("oneOf")
+	g.buffer.StartObject()
+	g.buffer.Field("$ref", g.schemaRef(dataName))
+	g.buffer.EndObject()
+	g.buffer.StartObject()
+	g.buffer.Field("$ref", g.schemaRef(linkName))
+	g.buffer.EndObject()
+	g.buffer.EndArray()
+	g.buffer.StartObject("discriminator")
+	g.buffer.Field("propertyName", "kind")
+	g.buffer.StartObject("mapping")
+	g.buffer.Field(name, g.schemaRef(dataName))
+	g.buffer.Field(linkName, g.schemaRef(linkName))
+	g.buffer.EndObject()
+	g.buffer.EndObject()
+	g.buffer.EndObject()
+
+	g.generatePlainStructSchema(typ, dataName, name)
+	g.generateLinkSchema(name, linkName)
+}
+
+// generateUnionSchema generates the `discriminator`/`oneOf` wrapper for a sum-type union, the same
+// way generateClassSchema does for the public schema of a class, except that the variants are the
+// union's own variant types (already generated as their own schemas) instead of a fixed Data/Link
+// pair.
+func (g *OpenAPIGenerator) generateUnionSchema(typ *concepts.Type) {
+	name := g.names.SchemaName(typ)
+
+	g.buffer.StartObject(name)
+	g.generateDescription(typ.Doc())
+	g.buffer.StartArray("oneOf")
+	for _, variant := range typ.Variants() {
+		g.buffer.StartObject()
+		g.buffer.Field("$ref", g.schemaRef(g.names.SchemaName(variant)))
+		g.buffer.EndObject()
+	}
+	g.buffer.EndArray()
+	g.buffer.StartObject("discriminator")
+	g.buffer.Field("propertyName", "kind")
+	g.buffer.StartObject("mapping")
+	for _, variant := range typ.Variants() {
+		variantName := g.names.SchemaName(variant)
+		g.buffer.Field(variantName, g.schemaRef(variantName))
+	}
+	g.buffer.EndObject()
+	g.buffer.EndObject()
+	g.buffer.EndObject()
+}
+
+// generatePlainStructSchema generates the schema for the properties of a struct, under the given
+// schema name. kindName is the public name used in the description of the `kind` property (which
+// differs from schemaName for the full representation of a class, since that is generated under
+// the `<Name>Data` schema).
+func (g *OpenAPIGenerator) generatePlainStructSchema(typ *concepts.Type, schemaName, kindName string) {
+	g.buffer.StartObject(schemaName)
+	g.generateDescription(typ.Doc())
 	g.buffer.StartObject("properties")
 	if typ.IsClass() {
 		// Kind:
@@ -405,7 +749,7 @@ func (g *OpenAPIGenerator) generateStructSchema(typ *concepts.Type) {
 		g.generateDescription(fmt.Sprintf(
 			"Indicates the type of this object. Will be '%s' if this is a complete "+
 				"object or '%sLink' if it is just a link.",
-			name, name,
+			kindName, kindName,
 		))
 		g.buffer.Field("type", "string")
 		g.buffer.EndObject()
@@ -425,6 +769,58 @@ func (g *OpenAPIGenerator) generateStructSchema(typ *concepts.Type) {
 	for _, attribute := range typ.Attributes() {
 		g.generateStructProperty(attribute)
 	}
+	g.buffer.EndObject()
+	g.generateRequired(typ)
+	g.buffer.EndObject()
+}
+
+// generateRequired emits the top-level `required` array listing the attributes that have been
+// marked with the `@Required` annotation.
+func (g *OpenAPIGenerator) generateRequired(typ *concepts.Type) {
+	var names []string
+	for _, attribute := range typ.Attributes() {
+		if attribute.Required() {
+			names = append(names, g.names.AttributePropertyName(attribute))
+		}
+	}
+	if len(names) == 0 {
+		return
+	}
+	g.buffer.StartArray("required")
+	for _, name := range names {
+		g.buffer.Item(name)
+	}
+	g.buffer.EndArray()
+}
+
+// generateLinkSchema generates the automatically derived link representation of a class,
+// containing only the `kind`, `id` and `href` properties.
+func (g *OpenAPIGenerator) generateLinkSchema(name, linkName string) {
+	g.buffer.StartObject(linkName)
+	g.generateDescription(fmt.Sprintf("Link to a '%s' object.", name))
+	g.buffer.StartObject("properties")
+
+	// Kind:
+	g.buffer.StartObject("kind")
+	g.generateDescription(fmt.Sprintf(
+		"Indicates the type of this object. Will always be '%s'.",
+		linkName,
+	))
+	g.buffer.Field("type", "string")
+	g.buffer.EndObject()
+
+	// ID:
+	g.buffer.StartObject("id")
+	g.generateDescription("Unique identifier of the object.")
+	g.buffer.Field("type", "string")
+	g.buffer.EndObject()
+
+	// HREF:
+	g.buffer.StartObject("href")
+	g.generateDescription("Self link.")
+	g.buffer.Field("type", "string")
+	g.buffer.EndObject()
+
 	g.buffer.EndObject()
 	g.buffer.EndObject()
 }
@@ -433,10 +829,71 @@ func (g *OpenAPIGenerator) generateStructProperty(attribute *concepts.Attribute)
 	name := g.names.AttributePropertyName(attribute)
 	g.buffer.StartObject(name)
 	g.generateDescription(attribute.Doc())
-	g.generateSchemaReference(attribute.Type())
+	g.generateNullableSchemaReference(attribute.Type(), attribute.Nullable(), attribute.Format())
+	g.generateConstraints(attribute)
+	g.generateExamples(attribute)
 	g.buffer.EndObject()
 }
 
+// generateExamples emits the `examples` array derived from the `@Example` annotations declared on
+// an attribute. JSON Schema 2020-12 prefers this plural form over the Draft-07-era singular
+// `example` keyword still used by OpenAPI 3.0.x, so the same array is emitted regardless of the
+// configured spec version.
+func (g *OpenAPIGenerator) generateExamples(attribute *concepts.Attribute) {
+	examples := attribute.Examples()
+	if len(examples) == 0 {
+		return
+	}
+	g.buffer.StartArray("examples")
+	for _, example := range examples {
+		g.buffer.Item(example)
+	}
+	g.buffer.EndArray()
+}
+
+// constrained is implemented by both concepts.Attribute and concepts.Parameter. It exposes the
+// validation annotations (@Min, @Max, @Pattern, @MinLength, @MaxLength, @Enum) parsed from the
+// model, so that generateConstraints can translate them into OpenAPI validation keywords
+// regardless of whether they were declared on an attribute or a parameter. @Format is handled
+// separately, by generateNullableSchemaReference, as it overrides the `format` that a scalar type
+// would otherwise set on its own, rather than being an independent keyword.
+type constrained interface {
+	Min() (float64, bool)
+	Max() (float64, bool)
+	Pattern() string
+	MinLength() (int, bool)
+	MaxLength() (int, bool)
+	Enum() []string
+}
+
+// generateConstraints emits the `minimum`, `maximum`, `pattern`, `minLength`, `maxLength` and
+// `enum` keywords derived from the validation annotations declared on an attribute or a
+// parameter.
+func (g *OpenAPIGenerator) generateConstraints(c constrained) {
+	if min, ok := c.Min(); ok {
+		g.buffer.Field("minimum", min)
+	}
+	if max, ok := c.Max(); ok {
+		g.buffer.Field("maximum", max)
+	}
+	if pattern := c.Pattern(); pattern != "" {
+		g.buffer.Field("pattern", pattern)
+	}
+	if minLength, ok := c.MinLength(); ok {
+		g.buffer.Field("minLength", minLength)
+	}
+	if maxLength, ok := c.MaxLength(); ok {
+		g.buffer.Field("maxLength", maxLength)
+	}
+	if values := c.Enum(); len(values) > 0 {
+		g.buffer.StartArray("enum")
+		for _, value := range values {
+			g.buffer.Item(value)
+		}
+		g.buffer.EndArray()
+	}
+}
+
 func (g *OpenAPIGenerator) generateSecurity(version *concepts.Version) {
 	g.buffer.StartArray("security")
 	g.buffer.StartObject()
@@ -447,36 +904,60 @@ func (g *OpenAPIGenerator) generateSecurity(version *concepts.Version) {
 }
 
 func (g *OpenAPIGenerator) generateSchemaReference(typ *concepts.Type) {
+	g.generateNullableSchemaReference(typ, false, "")
+}
+
+// generateNullableSchemaReference generates the schema for the given type, marking it as
+// nullable when requested. In 3.0.x mode nullability is expressed with the `nullable` keyword; in
+// 3.1.0 mode, where `nullable` has been removed from the JSON Schema 2020-12 vocabulary, it is
+// expressed by adding `null` to the `type` array instead. A `$ref` can't carry either of those as
+// a sibling keyword without changing its meaning, so enum and struct schemas (which are `$ref`s)
+// that need to be nullable are wrapped in a `oneOf` alongside a plain `{"type": "null"}`
+// alternative instead. List and map schemas aren't references, so they get the same `nullable`
+// keyword or `type` array as scalars.
+//
+// formatOverride, when not empty, is the `@Format` annotation declared on the attribute or
+// parameter that this schema is being generated for. It replaces the `format` that a scalar type
+// would otherwise derive from its own kind (for example `int32` for an integer), rather than
+// being emitted alongside it, since a schema can only have one `format` keyword. Binary schemas
+// ignore it and always use the encoding chosen by generateBinaryType.
+func (g *OpenAPIGenerator) generateNullableSchemaReference(typ *concepts.Type, nullable bool, formatOverride string) {
 	version := typ.Owner()
 	switch {
 	case typ == version.Boolean():
-		g.buffer.Field("type", "boolean")
+		g.generateScalarType("boolean", firstNonEmpty(formatOverride, ""), nullable)
 	case typ == version.Integer():
-		g.buffer.Field("type", "integer")
-		g.buffer.Field("format", "int32")
+		g.generateScalarType("integer", firstNonEmpty(formatOverride, "int32"), nullable)
 	case typ == version.Long():
-		g.buffer.Field("type", "integer")
-		g.buffer.Field("format", "int64")
+		g.generateScalarType("integer", firstNonEmpty(formatOverride, "int64"), nullable)
 	case typ == version.Float():
-		g.buffer.Field("type", "number")
-		g.buffer.Field("format", "float")
+		g.generateScalarType("number", firstNonEmpty(formatOverride, "float"), nullable)
 	case typ == version.String():
-		g.buffer.Field("type", "string")
+		g.generateScalarType("string", formatOverride, nullable)
 	case typ == version.Date():
-		g.buffer.Field("type", "string")
-		g.buffer.Field("format", "date-time")
+		g.generateScalarType("string", firstNonEmpty(formatOverride, "date-time"), nullable)
+	case typ == version.Binary():
+		g.generateBinaryType(nullable)
 	case typ.IsEnum() || typ.IsStruct():
-		g.buffer.Field("$ref", "#/components/schemas/"+g.names.SchemaName(typ))
+		if nullable {
+			g.generateNullableRef(func() {
+				g.buffer.Field("$ref", g.schemaRef(g.names.SchemaName(typ)))
+			})
+			return
+		}
+		g.buffer.Field("$ref", g.schemaRef(g.names.SchemaName(typ)))
 	case typ.IsList():
-		g.buffer.Field("type", "array")
-		g.buffer.StartObject("items")
-		g.generateSchemaReference(typ.Element())
-		g.buffer.EndObject()
+		g.generateArrayOrObjectType("array", nullable, func() {
+			g.buffer.StartObject("items")
+			g.generateSchemaReference(typ.Element())
+			g.buffer.EndObject()
+		})
 	case typ.IsMap():
-		g.buffer.Field("type", "object")
-		g.buffer.StartObject("additionalProperties")
-		g.generateSchemaReference(typ.Element())
-		g.buffer.EndObject()
+		g.generateArrayOrObjectType("object", nullable, func() {
+			g.buffer.StartObject("additionalProperties")
+			g.generateSchemaReference(typ.Element())
+			g.buffer.EndObject()
+		})
 	default:
 		g.reporter.Errorf(
 			"Don't know how to generate schema reference for type '%s'",
@@ -485,14 +966,141 @@ func (g *OpenAPIGenerator) generateSchemaReference(typ *concepts.Type) {
 	}
 }
 
+// generateNullableRef wraps a `$ref` schema (generated by the given function) in a `oneOf` with a
+// `{"type": "null"}` alternative, which is the only way to make a reference nullable without
+// changing what it refers to, in both 3.0.x and 3.1.0 mode.
+func (g *OpenAPIGenerator) generateNullableRef(generateRef func()) {
+	g.buffer.StartArray("oneOf")
+	g.buffer.StartObject()
+	generateRef()
+	g.buffer.EndObject()
+	g.buffer.StartObject()
+	g.buffer.Field("type", "null")
+	g.buffer.EndObject()
+	g.buffer.EndArray()
+}
+
+// generateArrayOrObjectType emits the `type` keyword (and, via the given function, the `items` or
+// `additionalProperties` keyword) for a list or map schema, honouring the configured spec version
+// when the schema is nullable, the same way generateScalarType does for scalars.
+func (g *OpenAPIGenerator) generateArrayOrObjectType(name string, nullable bool, generateRest func()) {
+	if nullable && g.is31() {
+		g.buffer.StartArray("type")
+		g.buffer.Item(name)
+		g.buffer.Item("null")
+		g.buffer.EndArray()
+	} else {
+		g.buffer.Field("type", name)
+		if nullable {
+			g.buffer.Field("nullable", true)
+		}
+	}
+	generateRest()
+}
+
+// generateScalarType emits the `type` (and, if given, `format`) keywords for a scalar schema,
+// honouring the configured spec version when the scalar is nullable.
+func (g *OpenAPIGenerator) generateScalarType(name string, format string, nullable bool) {
+	if nullable && g.is31() {
+		g.buffer.StartArray("type")
+		g.buffer.Item(name)
+		g.buffer.Item("null")
+		g.buffer.EndArray()
+	} else {
+		g.buffer.Field("type", name)
+		if nullable {
+			g.buffer.Field("nullable", true)
+		}
+	}
+	if format != "" {
+		g.buffer.Field("format", format)
+	}
+}
+
+// generateBinaryType emits the schema for a binary/file-upload attribute. In 3.0.x mode this is
+// `type: string, format: binary`; in 3.1.0 mode, where `format: binary` isn't part of the JSON
+// Schema 2020-12 vocabulary, it is expressed with `contentEncoding: base64` instead.
+func (g *OpenAPIGenerator) generateBinaryType(nullable bool) {
+	g.generateScalarType("string", "", nullable)
+	if g.is31() {
+		g.buffer.Field("contentEncoding", "base64")
+	} else {
+		g.buffer.Field("format", "binary")
+	}
+}
+
+// generateErrorSchema generates the discriminator/oneOf/Link trio for the generic `Error` schema,
+// the same way generateClassSchema does for a model class, so that `Error` is consistent with
+// every other `kind`-carrying schema instead of being a leftover flat object.
 func (g *OpenAPIGenerator) generateErrorSchema() {
-	g.buffer.StartObject("Error")
+	g.generateErrorLikeSchema("Error", func() {
+		g.generateDescription(
+			"Globally unique code of the error, composed of the unique identifier of the API " +
+				"and the numeric identifier of the error. For example, for if the " +
+				"numeric identifier of the error is `93` and the identifier of the API " +
+				"is `clusters_mgmt` then the code will be `CLUSTERS-MGMT-93`.",
+		)
+		g.buffer.Field("type", "string")
+	})
+}
+
+// generateErrorCodeSchema generates the discriminator/oneOf/Link trio for a schema that is
+// identical to the generic `Error` schema except that its `code` property is restricted, via
+// `enum`, to the specific code of the given error, so that it can be referenced from the response
+// of a method that declares it returns that particular error.
+func (g *OpenAPIGenerator) generateErrorCodeSchema(serviceError *concepts.Error) {
+	name := g.errorSchemaName(serviceError)
+	g.generateErrorLikeSchema(name, func() {
+		g.generateDescription(fmt.Sprintf(
+			"Globally unique code of the error, always '%s' for this schema.",
+			g.errorCode(serviceError),
+		))
+		g.buffer.Field("type", "string")
+		g.buffer.StartArray("enum")
+		g.buffer.Item(g.errorCode(serviceError))
+		g.buffer.EndArray()
+	})
+}
+
+// generateErrorLikeSchema generates the three schemas used to represent an error, whether it's
+// the generic `Error` schema or a per-error-code `Error_*` variant: the public schema (for
+// example `Error`), a `discriminator`/`oneOf` wrapper of the full representation and the link
+// representation, mirroring generateClassSchema; the full representation itself (`ErrorData`);
+// and the link representation (`ErrorLink`). generateCode emits the `code` property, which is the
+// only part that differs between the generic schema and a per-error-code one.
+func (g *OpenAPIGenerator) generateErrorLikeSchema(name string, generateCode func()) {
+	dataName := name + "Data"
+	linkName := name + "Link"
+
+	g.buffer.StartObject(name)
+	g.buffer.StartArray("oneOf")
+	g.buffer.StartObject()
+	g.buffer.Field("$ref", g.schemaRef(dataName))
+	g.buffer.EndObject()
+	g.buffer.StartObject()
+	g.buffer.Field("$ref", g.schemaRef(linkName))
+	g.buffer.EndObject()
+	g.buffer.EndArray()
+	g.buffer.StartObject("discriminator")
+	g.buffer.Field("propertyName", "kind")
+	g.buffer.StartObject("mapping")
+	g.buffer.Field(name, g.schemaRef(dataName))
+	g.buffer.Field(linkName, g.schemaRef(linkName))
+	g.buffer.EndObject()
+	g.buffer.EndObject()
+	g.buffer.EndObject()
+
+	g.buffer.StartObject(dataName)
 	g.buffer.Field("type", "object")
 	g.buffer.StartObject("properties")
 
 	// Kind:
 	g.buffer.StartObject("kind")
-	g.generateDescription("Indicates the type of this object. Will always be 'Error'")
+	g.generateDescription(fmt.Sprintf(
+		"Indicates the type of this object. Will be '%s' if this is a complete "+
+			"object or '%sLink' if it is just a link.",
+		name, name,
+	))
 	g.buffer.Field("type", "string")
 	g.buffer.EndObject()
 
@@ -511,13 +1119,7 @@ func (g *OpenAPIGenerator) generateErrorSchema() {
 
 	// Code:
 	g.buffer.StartObject("code")
-	g.generateDescription(
-		"Globally unique code of the error, composed of the unique identifier of the API " +
-			"and the numeric identifier of the error. For example, for if the " +
-			"numeric identifier of the error is `93` and the identifier of the API " +
-			"is `clusters_mgmt` then the code will be `CLUSTERS-MGMT-93`.",
-	)
-	g.buffer.Field("type", "string")
+	generateCode()
 	g.buffer.EndObject()
 
 	// Reason:
@@ -530,11 +1132,62 @@ func (g *OpenAPIGenerator) generateErrorSchema() {
 	g.buffer.StartObject("details")
 	g.generateDescription("Extra information about the error.")
 	g.buffer.Field("type", "object")
-	g.buffer.Field("additionalProperties", "true")
+	g.buffer.Field("additionalProperties", true)
 	g.buffer.EndObject()
 
 	g.buffer.EndObject()
 	g.buffer.EndObject()
+
+	g.generateErrorLinkSchema(name, linkName)
+}
+
+// generateErrorLinkSchema generates the automatically derived link representation of an error,
+// containing only the `kind`, `id` and `href` properties, the same way generateLinkSchema does
+// for a model class.
+func (g *OpenAPIGenerator) generateErrorLinkSchema(name, linkName string) {
+	g.buffer.StartObject(linkName)
+	g.generateDescription(fmt.Sprintf("Link to a '%s' object.", name))
+	g.buffer.StartObject("properties")
+
+	// Kind:
+	g.buffer.StartObject("kind")
+	g.generateDescription(fmt.Sprintf(
+		"Indicates the type of this object. Will always be '%s'.",
+		linkName,
+	))
+	g.buffer.Field("type", "string")
+	g.buffer.EndObject()
+
+	// ID:
+	g.buffer.StartObject("id")
+	g.generateDescription("Numeric identifier of the error.")
+	g.buffer.Field("type", "integer")
+	g.buffer.Field("format", "int32")
+	g.buffer.EndObject()
+
+	// HREF:
+	g.buffer.StartObject("href")
+	g.generateDescription("Self link.")
+	g.buffer.Field("type", "string")
+	g.buffer.EndObject()
+
+	g.buffer.EndObject()
+	g.buffer.EndObject()
+}
+
+// errorSchemaName calculates the name of the schema component generated for a specific service
+// error, for example `Error_CLUSTERS_MGMT_93`.
+func (g *OpenAPIGenerator) errorSchemaName(serviceError *concepts.Error) string {
+	service := strings.ToUpper(strings.ReplaceAll(serviceError.Service().Name().String(), "-", "_"))
+	return fmt.Sprintf("Error_%s_%d", service, serviceError.Code())
+}
+
+// errorCode calculates the globally unique code of a service error, for example
+// `CLUSTERS-MGMT-93`.
+func (g *OpenAPIGenerator) errorCode(serviceError *concepts.Error) string {
+	service := strings.ToUpper(strings.ReplaceAll(serviceError.Service().Name().String(), "-", "_"))
+	service = strings.ReplaceAll(service, "_", "-")
+	return fmt.Sprintf("%s-%d", service, serviceError.Code())
 }
 
 func (g *OpenAPIGenerator) generateDescription(doc string) {
@@ -560,4 +1213,12 @@ func (g *OpenAPIGenerator) absolutePath(path []*concepts.Locator) string {
 		version.Name(),
 		strings.Join(segments, "/"),
 	)
-}
\ No newline at end of file
+}
+
+// firstNonEmpty returns override if it isn't empty, and fallback otherwise.
+func firstNonEmpty(override, fallback string) string {
+	if override != "" {
+		return override
+	}
+	return fallback
+}
@@ -0,0 +1,151 @@
+/*
+Copyright (c) 2019 Red Hat, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+  http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package generators
+
+import (
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"reflect"
+	"testing"
+
+	"github.com/openshift-online/ocm-api-metamodel/pkg/concepts"
+	"github.com/openshift-online/ocm-api-metamodel/pkg/golang"
+	"github.com/openshift-online/ocm-api-metamodel/pkg/http"
+	"github.com/openshift-online/ocm-api-metamodel/pkg/openapi"
+	"github.com/openshift-online/ocm-api-metamodel/pkg/reporter"
+)
+
+// goldenFixture is the shape of the files under testdata/openapi.
+type goldenFixture struct {
+	Description string          `json:"description"`
+	Schema      json.RawMessage `json:"schema"`
+}
+
+// loadGoldenSchema reads one of the golden fixtures and decodes its `schema` field into a generic
+// value, ready to be compared with reflect.DeepEqual against the decoded output of the generator.
+func loadGoldenSchema(t *testing.T, name string) interface{} {
+	t.Helper()
+	data, err := os.ReadFile(filepath.Join("testdata", "openapi", name))
+	if err != nil {
+		t.Fatalf("can't read golden fixture '%s': %v", name, err)
+	}
+	var fixture goldenFixture
+	err = json.Unmarshal(data, &fixture)
+	if err != nil {
+		t.Fatalf("can't parse golden fixture '%s': %v", name, err)
+	}
+	var schema interface{}
+	err = json.Unmarshal(fixture.Schema, &schema)
+	if err != nil {
+		t.Fatalf("can't parse schema of golden fixture '%s': %v", name, err)
+	}
+	return schema
+}
+
+// newTestOpenAPIGenerator creates a generator wired up with real (if minimal) calculators, enough
+// to exercise the schema-reference generation logic without going through Run and a parsed model.
+func newTestOpenAPIGenerator(t *testing.T, specVersion SpecVersion) *OpenAPIGenerator {
+	t.Helper()
+	rep, err := reporter.NewReporter().Build()
+	if err != nil {
+		t.Fatalf("can't build reporter: %v", err)
+	}
+	packages := golang.NewPackagesCalculator()
+	buffer, err := openapi.NewBufferBuilder().
+		Reporter(rep).
+		Output(t.TempDir()).
+		Packages(packages).
+		Package("v1").
+		Build()
+	if err != nil {
+		t.Fatalf("can't build buffer: %v", err)
+	}
+	return &OpenAPIGenerator{
+		reporter:    rep,
+		names:       openapi.NewNamesCalculator(),
+		binding:     http.NewBindingCalculator(),
+		packages:    packages,
+		specVersion: specVersion,
+		mode:        ModeBundled,
+		buffer:      buffer,
+	}
+}
+
+// generateAndDecode runs generate with a fresh root object open on the generator's buffer, writes
+// the result out and decodes it back into a generic value for comparison.
+func generateAndDecode(t *testing.T, g *OpenAPIGenerator, generate func()) interface{} {
+	t.Helper()
+	g.buffer.StartObject()
+	generate()
+	g.buffer.EndObject()
+	err := g.buffer.Write()
+	if err != nil {
+		t.Fatalf("can't write buffer: %v", err)
+	}
+	data, err := os.ReadFile(g.buffer.Path())
+	if err != nil {
+		t.Fatalf("can't read generated file: %v", err)
+	}
+	var result interface{}
+	err = json.Unmarshal(data, &result)
+	if err != nil {
+		t.Fatalf("can't parse generated file: %v", err)
+	}
+	return result
+}
+
+func TestGenerateNullableSchemaReferenceStructRef300(t *testing.T) {
+	g := newTestOpenAPIGenerator(t, SpecVersion300)
+	version := concepts.NewVersion(concepts.NewName("v1"))
+	cluster := concepts.NewStructType(concepts.NewName("cluster"))
+	version.AddType(cluster)
+	got := generateAndDecode(t, g, func() {
+		g.generateNullableSchemaReference(cluster, true, "")
+	})
+	want := loadGoldenSchema(t, "nullable_300.json")
+	if !reflect.DeepEqual(got, want) {
+		t.Fatalf("schema doesn't match golden fixture:\ngot:  %#v\nwant: %#v", got, want)
+	}
+}
+
+func TestGenerateNullableSchemaReferenceStructRef310(t *testing.T) {
+	g := newTestOpenAPIGenerator(t, SpecVersion310)
+	version := concepts.NewVersion(concepts.NewName("v1"))
+	cluster := concepts.NewStructType(concepts.NewName("cluster"))
+	version.AddType(cluster)
+	got := generateAndDecode(t, g, func() {
+		g.generateNullableSchemaReference(cluster, true, "")
+	})
+	want := loadGoldenSchema(t, "nullable_310.json")
+	if !reflect.DeepEqual(got, want) {
+		t.Fatalf("schema doesn't match golden fixture:\ngot:  %#v\nwant: %#v", got, want)
+	}
+}
+
+func TestGenerateNullableSchemaReferenceList310(t *testing.T) {
+	g := newTestOpenAPIGenerator(t, SpecVersion310)
+	version := concepts.NewVersion(concepts.NewName("v1"))
+	list := concepts.NewListType(version, version.String())
+	got := generateAndDecode(t, g, func() {
+		g.generateNullableSchemaReference(list, true, "")
+	})
+	want := loadGoldenSchema(t, "nullable_list_310.json")
+	if !reflect.DeepEqual(got, want) {
+		t.Fatalf("schema doesn't match golden fixture:\ngot:  %#v\nwant: %#v", got, want)
+	}
+}
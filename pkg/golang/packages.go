@@ -0,0 +1,37 @@
+/*
+Copyright (c) 2019 Red Hat, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+  http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package golang contains the calculators used to derive Go package names from the model.
+package golang
+
+import (
+	"github.com/openshift-online/ocm-api-metamodel/pkg/concepts"
+)
+
+// PackagesCalculator calculates the names of the Go packages generated for the model.
+type PackagesCalculator struct {
+}
+
+// NewPackagesCalculator creates a new packages calculator.
+func NewPackagesCalculator() *PackagesCalculator {
+	return &PackagesCalculator{}
+}
+
+// VersionPackage calculates the name of the Go package used to hold the code generated for a
+// version, for example `v1`.
+func (c *PackagesCalculator) VersionPackage(version *concepts.Version) string {
+	return version.Name().String()
+}
@@ -0,0 +1,109 @@
+/*
+Copyright (c) 2019 Red Hat, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+  http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package http contains the calculator used to derive the HTTP binding details (verb, parameter
+// placement, status codes) of the methods and parameters of the model.
+package http
+
+import (
+	"github.com/openshift-online/ocm-api-metamodel/pkg/concepts"
+)
+
+// BindingCalculator calculates the HTTP binding details of the methods and parameters of the
+// model.
+type BindingCalculator struct {
+}
+
+// NewBindingCalculator creates a new binding calculator.
+func NewBindingCalculator() *BindingCalculator {
+	return &BindingCalculator{}
+}
+
+// Method calculates the HTTP verb used for a method, based on the convention encoded in its name:
+// `Add` is a `POST`, `Update` is a `PATCH`, `Delete` is a `DELETE`, and everything else (`Get`,
+// `List`, and any other custom action) is a `GET`.
+func (c *BindingCalculator) Method(method *concepts.Method) string {
+	switch method.Name().String() {
+	case "Add":
+		return "POST"
+	case "Update":
+		return "PATCH"
+	case "Delete":
+		return "DELETE"
+	default:
+		return "GET"
+	}
+}
+
+// RequestQueryParameters returns the request parameters of a method that are sent as query
+// parameters, which is every request parameter whose type isn't a struct or class, as those are
+// sent in the request body instead.
+func (c *BindingCalculator) RequestQueryParameters(method *concepts.Method) []*concepts.Parameter {
+	var result []*concepts.Parameter
+	for _, parameter := range method.RequestParameters() {
+		if !parameter.Type().IsStruct() {
+			result = append(result, parameter)
+		}
+	}
+	return result
+}
+
+// RequestBodyParameters returns the request parameters of a method that are sent in the request
+// body, which is every request parameter whose type is a struct or class.
+func (c *BindingCalculator) RequestBodyParameters(method *concepts.Method) []*concepts.Parameter {
+	var result []*concepts.Parameter
+	for _, parameter := range method.RequestParameters() {
+		if parameter.Type().IsStruct() {
+			result = append(result, parameter)
+		}
+	}
+	return result
+}
+
+// ResponseParameters returns the parameters that a method sends back in its response.
+func (c *BindingCalculator) ResponseParameters(method *concepts.Method) []*concepts.Parameter {
+	return method.ResponseParameters()
+}
+
+// LocatorSegment calculates the name used, together with the `_id` suffix, for the path variable
+// of a locator.
+func (c *BindingCalculator) LocatorSegment(locator *concepts.Locator) string {
+	return locator.Name().String()
+}
+
+// ParameterName calculates the wire name used for a query parameter.
+func (c *BindingCalculator) ParameterName(parameter *concepts.Parameter) string {
+	return parameter.Name().String()
+}
+
+// DefaultStatus calculates the HTTP status code of the success response of a method, based on the
+// same naming convention used by Method: `201` for `Add`, `204` for `Delete`, and `200` for
+// everything else.
+func (c *BindingCalculator) DefaultStatus(method *concepts.Method) string {
+	switch method.Name().String() {
+	case "Add":
+		return "201"
+	case "Delete":
+		return "204"
+	default:
+		return "200"
+	}
+}
+
+// EnumValueName calculates the wire name used for an enum value.
+func (c *BindingCalculator) EnumValueName(value *concepts.EnumValue) string {
+	return value.Name().String()
+}
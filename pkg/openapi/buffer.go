@@ -0,0 +1,280 @@
+/*
+Copyright (c) 2019 Red Hat, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+  http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package openapi
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+
+	"github.com/openshift-online/ocm-api-metamodel/pkg/golang"
+	"github.com/openshift-online/ocm-api-metamodel/pkg/reporter"
+)
+
+// orderedMap is a map that remembers the order in which keys were first inserted, so that the
+// generated documents have a predictable, human-friendly field order instead of the random order
+// that a plain Go map would produce.
+type orderedMap struct {
+	keys   []string
+	values map[string]interface{}
+}
+
+func newOrderedMap() *orderedMap {
+	return &orderedMap{
+		values: map[string]interface{}{},
+	}
+}
+
+func (m *orderedMap) set(key string, value interface{}) {
+	if _, exists := m.values[key]; !exists {
+		m.keys = append(m.keys, key)
+	}
+	m.values[key] = value
+}
+
+// orderedArray is the array counterpart of orderedMap.
+type orderedArray struct {
+	items []interface{}
+}
+
+// frame represents one of the objects or arrays currently being built, waiting to be closed and
+// attached to its parent.
+type frame struct {
+	name  string
+	array bool
+	om    *orderedMap
+	oa    *orderedArray
+}
+
+// Buffer accumulates a single OpenAPI or JSON Schema document as a tree of nested objects and
+// arrays, preserving field order, and writes it out as JSON or YAML once it has been populated.
+// Don't create instances directly, use NewBufferBuilder instead.
+type Buffer struct {
+	reporter *reporter.Reporter
+	output   string
+	pkg      string
+	format   Format
+	suffix   string
+	stack    []*frame
+	root     interface{}
+}
+
+// StartObject begins a new object. If a name is given, the object will be attached to its parent
+// under that name once EndObject is called; otherwise it is attached as the next item of the
+// parent array, or becomes the document root if there is no parent.
+func (b *Buffer) StartObject(name ...string) {
+	b.push(nameOf(name), false)
+}
+
+// EndObject closes the object started by the matching StartObject call.
+func (b *Buffer) EndObject() {
+	f := b.pop()
+	b.attach(f.name, f.om)
+}
+
+// StartArray begins a new array, with the same naming rules as StartObject.
+func (b *Buffer) StartArray(name ...string) {
+	b.push(nameOf(name), true)
+}
+
+// EndArray closes the array started by the matching StartArray call.
+func (b *Buffer) EndArray() {
+	f := b.pop()
+	b.attach(f.name, f.oa)
+}
+
+// Field adds a named field to the object currently being built.
+func (b *Buffer) Field(name string, value interface{}) {
+	top := b.top()
+	top.om.set(name, value)
+}
+
+// Item adds an item to the array currently being built.
+func (b *Buffer) Item(value interface{}) {
+	top := b.top()
+	top.oa.items = append(top.oa.items, value)
+}
+
+// Path returns the file that Write will write the document to.
+func (b *Buffer) Path() string {
+	return b.path()
+}
+
+// Write serializes the document, in the configured format, to the file calculated from the output
+// directory, package and suffix that were used to build the buffer.
+func (b *Buffer) Write() error {
+	path := b.path()
+	err := os.MkdirAll(filepath.Dir(path), 0755)
+	if err != nil {
+		return fmt.Errorf("can't create directory for file '%s': %v", path, err)
+	}
+	var data []byte
+	switch b.format {
+	case FormatYAML:
+		data = marshalYAML(b.root)
+	default:
+		data = marshalJSON(b.root)
+	}
+	err = os.WriteFile(path, data, 0644)
+	if err != nil {
+		return fmt.Errorf("can't write file '%s': %v", path, err)
+	}
+	return nil
+}
+
+// path calculates the file that the document will be written to.
+func (b *Buffer) path() string {
+	name := b.pkg
+	if b.suffix == "components" {
+		name = "_components"
+	} else if b.suffix != "" {
+		name = b.suffix
+	}
+	file := fmt.Sprintf("%s.%s", name, b.format.extension())
+	return filepath.Join(b.output, b.pkg, file)
+}
+
+func (b *Buffer) push(name string, array bool) {
+	f := &frame{name: name, array: array}
+	if array {
+		f.oa = &orderedArray{}
+	} else {
+		f.om = newOrderedMap()
+	}
+	b.stack = append(b.stack, f)
+}
+
+func (b *Buffer) pop() *frame {
+	n := len(b.stack)
+	f := b.stack[n-1]
+	b.stack = b.stack[:n-1]
+	return f
+}
+
+func (b *Buffer) top() *frame {
+	return b.stack[len(b.stack)-1]
+}
+
+// attach hangs the just-closed container off its parent: as a named field if the parent is an
+// object, as the next item if the parent is an array, or as the document root if there is no
+// parent left.
+func (b *Buffer) attach(name string, value interface{}) {
+	if len(b.stack) == 0 {
+		b.root = value
+		return
+	}
+	parent := b.top()
+	if parent.array {
+		parent.oa.items = append(parent.oa.items, value)
+	} else {
+		parent.om.set(name, value)
+	}
+}
+
+func nameOf(name []string) string {
+	if len(name) == 0 {
+		return ""
+	}
+	return name[0]
+}
+
+// BufferBuilder is an object used to configure and build buffers. Don't create instances directly,
+// use the NewBufferBuilder function instead.
+type BufferBuilder struct {
+	reporter *reporter.Reporter
+	output   string
+	packages *golang.PackagesCalculator
+	pkg      string
+	format   Format
+	suffix   string
+}
+
+// NewBufferBuilder creates a new builder for buffers.
+func NewBufferBuilder() *BufferBuilder {
+	return &BufferBuilder{}
+}
+
+// Reporter sets the object that will be used to report errors.
+func (b *BufferBuilder) Reporter(value *reporter.Reporter) *BufferBuilder {
+	b.reporter = value
+	return b
+}
+
+// Output sets the output directory.
+func (b *BufferBuilder) Output(value string) *BufferBuilder {
+	b.output = value
+	return b
+}
+
+// Packages sets the object that will be used to calculate Go package names.
+func (b *BufferBuilder) Packages(value *golang.PackagesCalculator) *BufferBuilder {
+	b.packages = value
+	return b
+}
+
+// Package sets the name of the package that the generated document belongs to.
+func (b *BufferBuilder) Package(value string) *BufferBuilder {
+	b.pkg = value
+	return b
+}
+
+// Format sets the serialization format used to write the document. Defaults to FormatJSON.
+func (b *BufferBuilder) Format(value Format) *BufferBuilder {
+	b.format = value
+	return b
+}
+
+// Suffix sets the name used, together with the package and format, to calculate the name of the
+// generated file, for example `components` for `_components.json`. Defaults to empty, which
+// generates a file named after the package, for example `v1.json`.
+func (b *BufferBuilder) Suffix(value string) *BufferBuilder {
+	b.suffix = value
+	return b
+}
+
+// Build checks the configuration stored in the builder and, if it is correct, creates a new
+// buffer using it.
+func (b *BufferBuilder) Build() (buffer *Buffer, err error) {
+	if b.reporter == nil {
+		err = fmt.Errorf("reporter is mandatory")
+		return
+	}
+	if b.output == "" {
+		err = fmt.Errorf("output directory is mandatory")
+		return
+	}
+	if b.packages == nil {
+		err = fmt.Errorf("packages calculator is mandatory")
+		return
+	}
+	if b.pkg == "" {
+		err = fmt.Errorf("package is mandatory")
+		return
+	}
+	format := b.format
+	if format == "" {
+		format = FormatJSON
+	}
+	buffer = &Buffer{
+		reporter: b.reporter,
+		output:   b.output,
+		pkg:      b.pkg,
+		format:   format,
+		suffix:   b.suffix,
+	}
+	return
+}
@@ -0,0 +1,38 @@
+/*
+Copyright (c) 2019 Red Hat, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+  http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package openapi
+
+// Format specifies the serialization format used to write a generated document.
+type Format string
+
+const (
+	// FormatJSON writes the document as JSON. This is the default.
+	FormatJSON Format = "json"
+
+	// FormatYAML writes the document as YAML.
+	FormatYAML Format = "yaml"
+)
+
+// extension returns the file name extension used for the format.
+func (f Format) extension() string {
+	switch f {
+	case FormatYAML:
+		return "yaml"
+	default:
+		return "json"
+	}
+}
@@ -0,0 +1,222 @@
+/*
+Copyright (c) 2019 Red Hat, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+  http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package openapi
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+)
+
+// marshalJSON renders the tree built by a Buffer as indented JSON, preserving the field order
+// recorded by the orderedMap/orderedArray nodes, which a plain `json.Marshal` of a `map[string]
+// interface{}` wouldn't do.
+func marshalJSON(root interface{}) []byte {
+	buf := &bytes.Buffer{}
+	writeJSON(buf, root, 0)
+	buf.WriteByte('\n')
+	return buf.Bytes()
+}
+
+func writeJSON(buf *bytes.Buffer, value interface{}, indent int) {
+	switch typed := value.(type) {
+	case *orderedMap:
+		if len(typed.keys) == 0 {
+			buf.WriteString("{}")
+			return
+		}
+		buf.WriteString("{\n")
+		for i, key := range typed.keys {
+			writeJSONIndent(buf, indent+1)
+			buf.WriteString(jsonQuote(key))
+			buf.WriteString(": ")
+			writeJSON(buf, typed.values[key], indent+1)
+			if i < len(typed.keys)-1 {
+				buf.WriteByte(',')
+			}
+			buf.WriteByte('\n')
+		}
+		writeJSONIndent(buf, indent)
+		buf.WriteByte('}')
+	case *orderedArray:
+		if len(typed.items) == 0 {
+			buf.WriteString("[]")
+			return
+		}
+		buf.WriteString("[\n")
+		for i, item := range typed.items {
+			writeJSONIndent(buf, indent+1)
+			writeJSON(buf, item, indent+1)
+			if i < len(typed.items)-1 {
+				buf.WriteByte(',')
+			}
+			buf.WriteByte('\n')
+		}
+		writeJSONIndent(buf, indent)
+		buf.WriteByte(']')
+	case string:
+		buf.WriteString(jsonQuote(typed))
+	case bool:
+		if typed {
+			buf.WriteString("true")
+		} else {
+			buf.WriteString("false")
+		}
+	case nil:
+		buf.WriteString("null")
+	case int:
+		buf.WriteString(strconv.Itoa(typed))
+	case float64:
+		buf.WriteString(strconv.FormatFloat(typed, 'g', -1, 64))
+	default:
+		fmt.Fprintf(buf, "%v", typed)
+	}
+}
+
+func writeJSONIndent(buf *bytes.Buffer, indent int) {
+	for i := 0; i < indent; i++ {
+		buf.WriteString("  ")
+	}
+}
+
+func jsonQuote(text string) string {
+	data, _ := json.Marshal(text)
+	return string(data)
+}
+
+// marshalYAML renders the tree built by a Buffer as YAML, using the same field order as the JSON
+// output.
+func marshalYAML(root interface{}) []byte {
+	buf := &bytes.Buffer{}
+	writeYAMLNode(buf, root, 0)
+	return buf.Bytes()
+}
+
+func writeYAMLNode(buf *bytes.Buffer, value interface{}, indent int) {
+	switch typed := value.(type) {
+	case *orderedMap:
+		writeYAMLMap(buf, typed, indent)
+	case *orderedArray:
+		writeYAMLArray(buf, typed, indent)
+	default:
+		buf.WriteString(yamlScalar(typed))
+		buf.WriteByte('\n')
+	}
+}
+
+func writeYAMLMap(buf *bytes.Buffer, m *orderedMap, indent int) {
+	if len(m.keys) == 0 {
+		buf.WriteString("{}\n")
+		return
+	}
+	for _, key := range m.keys {
+		writeYAMLIndent(buf, indent)
+		buf.WriteString(yamlKey(key))
+		buf.WriteByte(':')
+		writeYAMLChild(buf, m.values[key], indent)
+	}
+}
+
+func writeYAMLArray(buf *bytes.Buffer, a *orderedArray, indent int) {
+	if len(a.items) == 0 {
+		buf.WriteString("[]\n")
+		return
+	}
+	for _, item := range a.items {
+		writeYAMLIndent(buf, indent)
+		buf.WriteByte('-')
+		writeYAMLChild(buf, item, indent)
+	}
+}
+
+// writeYAMLChild writes the value that follows a `key:` or `-` marker: nested, non-empty
+// containers start on the next line, indented one level further; everything else is written
+// inline on the same line.
+func writeYAMLChild(buf *bytes.Buffer, value interface{}, indent int) {
+	switch typed := value.(type) {
+	case *orderedMap:
+		if len(typed.keys) == 0 {
+			buf.WriteString(" {}\n")
+			return
+		}
+		buf.WriteByte('\n')
+		writeYAMLNode(buf, typed, indent+1)
+	case *orderedArray:
+		if len(typed.items) == 0 {
+			buf.WriteString(" []\n")
+			return
+		}
+		buf.WriteByte('\n')
+		writeYAMLNode(buf, typed, indent+1)
+	default:
+		buf.WriteByte(' ')
+		writeYAMLNode(buf, value, indent)
+	}
+}
+
+func writeYAMLIndent(buf *bytes.Buffer, indent int) {
+	for i := 0; i < indent; i++ {
+		buf.WriteString("  ")
+	}
+}
+
+var yamlPlainRE = regexp.MustCompile(`^[A-Za-z_][A-Za-z0-9_./-]*$`)
+var yamlNumberRE = regexp.MustCompile(`^-?[0-9]+(\.[0-9]+)?$`)
+var yamlReserved = map[string]bool{
+	"true": true, "false": true, "null": true, "yes": true, "no": true, "~": true,
+}
+
+func yamlKey(text string) string {
+	return yamlPlain(text)
+}
+
+func yamlScalar(value interface{}) string {
+	switch typed := value.(type) {
+	case string:
+		return yamlPlain(typed)
+	case bool:
+		if typed {
+			return "true"
+		}
+		return "false"
+	case nil:
+		return "null"
+	case int:
+		return strconv.Itoa(typed)
+	case float64:
+		return strconv.FormatFloat(typed, 'g', -1, 64)
+	default:
+		return fmt.Sprintf("%v", typed)
+	}
+}
+
+// yamlPlain returns text unquoted if it is safe to do so as a bare YAML scalar, and a
+// JSON-escaped (and therefore also valid YAML) double-quoted string otherwise.
+func yamlPlain(text string) string {
+	lower := strings.ToLower(text)
+	safe := text != "" &&
+		yamlPlainRE.MatchString(text) &&
+		!yamlNumberRE.MatchString(text) &&
+		!yamlReserved[lower]
+	if safe {
+		return text
+	}
+	return jsonQuote(text)
+}
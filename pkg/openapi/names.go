@@ -0,0 +1,62 @@
+/*
+Copyright (c) 2019 Red Hat, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+  http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package openapi
+
+import (
+	"strings"
+
+	"github.com/openshift-online/ocm-api-metamodel/pkg/concepts"
+)
+
+// NamesCalculator calculates the names used for OpenAPI and JSON Schema components and
+// properties.
+type NamesCalculator struct {
+}
+
+// NewNamesCalculator creates a new names calculator.
+func NewNamesCalculator() *NamesCalculator {
+	return &NamesCalculator{}
+}
+
+// SchemaName calculates the name of the schema component generated for a type, for example
+// `Cluster`.
+func (c *NamesCalculator) SchemaName(typ *concepts.Type) string {
+	return pascalCase(typ.Name().String())
+}
+
+// AttributePropertyName calculates the wire name used for an attribute, for example `dns_name`.
+func (c *NamesCalculator) AttributePropertyName(attribute *concepts.Attribute) string {
+	return attribute.Name().String()
+}
+
+// ParameterPropertyName calculates the wire name used for a parameter when it appears as a
+// response property.
+func (c *NamesCalculator) ParameterPropertyName(parameter *concepts.Parameter) string {
+	return parameter.Name().String()
+}
+
+// pascalCase converts a snake_case model name into the PascalCase form used for schema names.
+func pascalCase(text string) string {
+	parts := strings.Split(text, "_")
+	for i, part := range parts {
+		if part == "" {
+			continue
+		}
+		parts[i] = strings.ToUpper(part[:1]) + part[1:]
+	}
+	return strings.Join(parts, "")
+}
@@ -0,0 +1,57 @@
+/*
+Copyright (c) 2019 Red Hat, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+  http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package reporter contains the object used to report diagnostic messages produced while running
+// a generator.
+package reporter
+
+import (
+	"fmt"
+	"os"
+)
+
+// ReporterBuilder is an object used to configure and build reporters. Don't create instances
+// directly, use the NewReporter function instead.
+type ReporterBuilder struct {
+}
+
+// Reporter collects and prints the diagnostic messages produced while running a generator.
+type Reporter struct {
+	errors int
+}
+
+// NewReporter creates a new builder for reporters.
+func NewReporter() *ReporterBuilder {
+	return &ReporterBuilder{}
+}
+
+// Build creates a new reporter using the configuration stored in the builder.
+func (b *ReporterBuilder) Build() (reporter *Reporter, err error) {
+	reporter = &Reporter{}
+	return
+}
+
+// Errorf reports an error, formatting the message with the given format and arguments, and
+// increments the count of errors.
+func (r *Reporter) Errorf(format string, args ...interface{}) {
+	r.errors++
+	fmt.Fprintf(os.Stderr, "E: "+format+"\n", args...)
+}
+
+// Errors returns the number of errors reported so far.
+func (r *Reporter) Errors() int {
+	return r.errors
+}